@@ -0,0 +1,24 @@
+-- services/backlog-service/migrations/000009_activity_feed.up.sql
+
+-- Activity feed projection: one denormalized row per interesting event,
+-- written by the activity projection consumer and read newest-first by
+-- GetActivityFeed. BIGSERIAL ids double as the pagination cursor.
+CREATE TABLE activity_feed (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id UUID,
+    event_type VARCHAR(50) NOT NULL,
+    item_id UUID NOT NULL,
+    item_title TEXT NOT NULL DEFAULT '',
+    item_type VARCHAR(20) NOT NULL DEFAULT '',
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Reverse-chronological reads are the only access pattern; the descending
+-- index serves both the global feed and per-workspace feeds
+CREATE INDEX idx_activity_feed_id_desc ON activity_feed(id DESC);
+CREATE INDEX idx_activity_feed_workspace_id_desc ON activity_feed(workspace_id, id DESC);
+
+-- services/backlog-service/migrations/000009_activity_feed.down.sql
+
+DROP TABLE IF EXISTS activity_feed;
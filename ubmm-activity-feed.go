@@ -0,0 +1,224 @@
+// services/backlog-service/internal/domain/service/activity_feed.go
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ActivityFeedService serves the per-workspace activity feed: a merged,
+// reverse-chronological stream of recent backlog events. Reads hit the
+// activity projection table only, so the feed stays cheap no matter how many
+// event types feed it.
+type ActivityFeedService struct {
+	activity repository.ActivityRepository
+	logger   *zap.Logger
+}
+
+// NewActivityFeedService creates a new activity feed service
+func NewActivityFeedService(activity repository.ActivityRepository, logger *zap.Logger) *ActivityFeedService {
+	return &ActivityFeedService{
+		activity: activity,
+		logger:   logger,
+	}
+}
+
+// GetActivityFeed retrieves a page of the feed, newest first. A nil
+// workspace reads the global feed; a zero cursor starts from the newest
+// entry. The returned cursor fetches the next page, zero at the end.
+func (s *ActivityFeedService) GetActivityFeed(ctx context.Context, workspaceID *uuid.UUID, cursor int64, limit int) ([]*repository.ActivityEntry, int64, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	return s.activity.ListActivity(ctx, workspaceID, cursor, limit)
+}
+
+// services/backlog-service/internal/adapters/eventbus/activity_consumer.go
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// activityTopics are the event streams merged into the activity feed
+var activityTopics = []string{
+	"backlog.item.created",
+	"backlog.item.updated",
+	"backlog.item.deleted",
+	"backlog.item.status.derived",
+	"backlog.item.blocked",
+	"backlog.item.unblocked",
+	"backlog.item.external_id.set",
+	"backlog.item.external_id.removed",
+	"backlog.sync.conflict.detected",
+	"backlog.sync.conflict.resolved",
+}
+
+// ActivityFeedConsumer projects backlog events into the activity feed table.
+// One consumer group is shared across replicas so each event becomes exactly
+// one feed entry.
+type ActivityFeedConsumer struct {
+	consumer *KafkaConsumer
+	activity repository.ActivityRepository
+	logger   *zap.Logger
+}
+
+// NewActivityFeedConsumer creates a new activity feed consumer
+func NewActivityFeedConsumer(cfg config.KafkaConfig, activity repository.ActivityRepository, logger *zap.Logger) (*ActivityFeedConsumer, error) {
+	consumer, err := NewKafkaConsumer(cfg, "backlog-activity-projection", logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create activity feed consumer: %w", err)
+	}
+	if err := consumer.Subscribe(activityTopics); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("failed to subscribe to activity topics: %w", err)
+	}
+
+	return &ActivityFeedConsumer{
+		consumer: consumer,
+		activity: activity,
+		logger:   logger,
+	}, nil
+}
+
+// activityPayload is the slice of the event payload the consumer reads; the
+// embedded item summary makes the feed entry renderable without lookups
+type activityPayload struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	ItemID    string    `json:"itemId"`
+	Actor     string    `json:"actor"`
+	Item      *struct {
+		Title string `json:"title"`
+		Type  string `json:"type"`
+	} `json:"item"`
+}
+
+// Start consumes events into the feed until the context is cancelled
+func (c *ActivityFeedConsumer) Start(ctx context.Context) error {
+	return c.consumer.ConsumeMessages(ctx, func(message *kafka.Message) error {
+		var payload activityPayload
+		if err := json.Unmarshal(message.Value, &payload); err != nil {
+			c.logger.Error("Failed to parse activity event", zap.Error(err))
+			return nil
+		}
+
+		itemID, err := uuid.Parse(payload.ItemID)
+		if err != nil {
+			return nil
+		}
+
+		entry := &repository.ActivityEntry{
+			EventType:  payload.Type,
+			ItemID:     itemID,
+			Actor:      payload.Actor,
+			OccurredAt: payload.Timestamp,
+		}
+		if payload.Item != nil {
+			entry.ItemTitle = payload.Item.Title
+			entry.ItemType = payload.Item.Type
+		}
+		if entry.OccurredAt.IsZero() {
+			entry.OccurredAt = time.Now().UTC()
+		}
+
+		if err := c.activity.AppendActivity(ctx, entry); err != nil {
+			// Returning the error leaves the offset uncommitted, so the
+			// entry is retried rather than lost
+			c.logger.Error("Failed to append activity entry",
+				zap.String("itemID", payload.ItemID),
+				zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
+
+// Close shuts the consumer down
+func (c *ActivityFeedConsumer) Close() error {
+	return c.consumer.Close()
+}
+
+// services/backlog-service/internal/adapters/grpc/activity_server.go
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ubmm/backlog-service/internal/domain/service"
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// ActivityServer implements the gRPC activity feed service
+type ActivityServer struct {
+	pb.UnimplementedActivityServiceServer
+	service *service.ActivityFeedService
+	logger  *zap.Logger
+}
+
+// NewActivityServer creates a new activity gRPC server
+func NewActivityServer(service *service.ActivityFeedService, logger *zap.Logger) *ActivityServer {
+	return &ActivityServer{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetActivityFeed retrieves a page of the activity feed, newest first
+func (s *ActivityServer) GetActivityFeed(ctx context.Context, req *pb.GetActivityFeedRequest) (*pb.GetActivityFeedResponse, error) {
+	var workspaceID *uuid.UUID
+	if req.WorkspaceId != "" {
+		parsed, err := uuid.Parse(req.WorkspaceId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid workspace ID: %v", err)
+		}
+		workspaceID = &parsed
+	}
+
+	entries, nextCursor, err := s.service.GetActivityFeed(ctx, workspaceID, req.Cursor, int(req.PageSize))
+	if err != nil {
+		s.logger.Error("Failed to get activity feed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get activity feed: %v", err)
+	}
+
+	response := &pb.GetActivityFeedResponse{
+		NextCursor: nextCursor,
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, &pb.ActivityEntry{
+			Id:         entry.ID,
+			EventType:  entry.EventType,
+			ItemId:     entry.ItemID.String(),
+			ItemTitle:  entry.ItemTitle,
+			ItemType:   entry.ItemType,
+			Actor:      entry.Actor,
+			OccurredAt: timestamppb.New(entry.OccurredAt),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,182 @@
+// services/backlog-service/internal/domain/service/approvals.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// ApprovalState is the state of an item in the approval gate
+type ApprovalState string
+
+const (
+	// ApprovalStatePending represents an approval awaiting a decision
+	ApprovalStatePending ApprovalState = "PENDING"
+	// ApprovalStateApproved represents a granted approval
+	ApprovalStateApproved ApprovalState = "APPROVED"
+	// ApprovalStateRejected represents a rejected approval
+	ApprovalStateRejected ApprovalState = "REJECTED"
+)
+
+// Approval is one pass of an item through the approval gate
+type Approval struct {
+	ID          uuid.UUID     `json:"id"`
+	ItemID      uuid.UUID     `json:"itemId"`
+	State       ApprovalState `json:"state"`
+	RequestedBy string        `json:"requestedBy"`
+	RequestedAt time.Time     `json:"requestedAt"`
+	DecidedBy   string        `json:"decidedBy,omitempty"`
+	DecidedAt   *time.Time    `json:"decidedAt,omitempty"`
+	Comment     string        `json:"comment,omitempty"`
+}
+
+// ApprovalRepository persists approval records
+type ApprovalRepository interface {
+	// Create stores a new approval
+	Create(ctx context.Context, approval *Approval) error
+
+	// Update updates an existing approval
+	Update(ctx context.Context, approval *Approval) error
+
+	// GetPendingByItemID retrieves the pending approval for an item, if any
+	GetPendingByItemID(ctx context.Context, itemID uuid.UUID) (*Approval, error)
+
+	// ListPending lists all pending approvals
+	ListPending(ctx context.Context) ([]*Approval, error)
+}
+
+// ApprovalPolicy holds the configurable thresholds above which an epic
+// requires sign-off before work starts; zero values disable that check
+type ApprovalPolicy struct {
+	StoryPointsThreshold int     `json:"storyPointsThreshold"`
+	CostThreshold        float64 `json:"costThreshold"`
+}
+
+// ApprovalService gates large epics behind an explicit approval step
+type ApprovalService struct {
+	backlog        *BacklogService
+	approvals      ApprovalRepository
+	policy         ApprovalPolicy
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewApprovalService creates a new approval service
+func NewApprovalService(backlog *BacklogService, approvals ApprovalRepository, policy ApprovalPolicy, eventPublisher event.Publisher, logger *zap.Logger) *ApprovalService {
+	return &ApprovalService{
+		backlog:        backlog,
+		approvals:      approvals,
+		policy:         policy,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// RequiresApproval reports whether an item crosses the configured thresholds
+func (s *ApprovalService) RequiresApproval(item *model.BacklogItem) bool {
+	if item.Type != model.ItemTypeEpic {
+		return false
+	}
+	if s.policy.StoryPointsThreshold > 0 && item.StoryPoints >= s.policy.StoryPointsThreshold {
+		return true
+	}
+	if s.policy.CostThreshold > 0 && item.CostOfDelayPerWeek*item.DurationEstimateWeeks >= s.policy.CostThreshold {
+		return true
+	}
+	return false
+}
+
+// RequestApproval puts an epic into the approval gate. It is a no-op error
+// if the item does not cross the thresholds or already has a pending
+// approval.
+func (s *ApprovalService) RequestApproval(ctx context.Context, itemID uuid.UUID, requestedBy string) (*Approval, error) {
+	item, err := s.backlog.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.RequiresApproval(item) {
+		return nil, errors.New("item does not require approval")
+	}
+	if pending, err := s.approvals.GetPendingByItemID(ctx, itemID); err == nil && pending != nil {
+		return nil, errors.New("item already has a pending approval")
+	}
+
+	approval := &Approval{
+		ID:          uuid.New(),
+		ItemID:      itemID,
+		State:       ApprovalStatePending,
+		RequestedBy: requestedBy,
+		RequestedAt: time.Now().UTC(),
+	}
+	if err := s.approvals.Create(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to store approval: %w", err)
+	}
+
+	requestedEvent := event.NewApprovalRequestedEvent(itemID, requestedBy, item.StoryPoints)
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.approval.requested", requestedEvent); err != nil {
+		s.logger.Error("Failed to publish approval requested event", zap.Error(err))
+	}
+
+	return approval, nil
+}
+
+// Approve grants a pending approval
+func (s *ApprovalService) Approve(ctx context.Context, itemID uuid.UUID, decidedBy, comment string) (*Approval, error) {
+	return s.decide(ctx, itemID, decidedBy, comment, true)
+}
+
+// Reject rejects a pending approval; the epic stays blocked from starting
+func (s *ApprovalService) Reject(ctx context.Context, itemID uuid.UUID, decidedBy, comment string) (*Approval, error) {
+	return s.decide(ctx, itemID, decidedBy, comment, false)
+}
+
+// ListPending lists the items waiting on an approval decision
+func (s *ApprovalService) ListPending(ctx context.Context) ([]*Approval, error) {
+	return s.approvals.ListPending(ctx)
+}
+
+// decide resolves a pending approval and emits the audit event
+func (s *ApprovalService) decide(ctx context.Context, itemID uuid.UUID, decidedBy, comment string, approved bool) (*Approval, error) {
+	approval, err := s.approvals.GetPendingByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil {
+		return nil, errors.New("no pending approval for item")
+	}
+
+	now := time.Now().UTC()
+	if approved {
+		approval.State = ApprovalStateApproved
+	} else {
+		approval.State = ApprovalStateRejected
+	}
+	approval.DecidedBy = decidedBy
+	approval.DecidedAt = &now
+	approval.Comment = comment
+
+	if err := s.approvals.Update(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to update approval: %w", err)
+	}
+
+	decidedEvent := event.NewApprovalDecidedEvent(itemID, decidedBy, approved, comment)
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.approval.decided", decidedEvent); err != nil {
+		s.logger.Error("Failed to publish approval decided event", zap.Error(err))
+	}
+
+	s.logger.Info("Approval decided",
+		zap.String("itemId", itemID.String()),
+		zap.Bool("approved", approved),
+		zap.String("decidedBy", decidedBy))
+
+	return approval, nil
+}
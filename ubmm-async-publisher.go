@@ -0,0 +1,206 @@
+// services/backlog-service/internal/adapters/eventbus/async_publisher.go
+
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// Backpressure policies for a full publish queue
+const (
+	// BackpressureBlock makes Publish wait for queue space, propagating the
+	// broker's pressure back to the caller
+	BackpressureBlock = "block"
+	// BackpressureDropOldest evicts the oldest queued event to admit the new
+	// one; use only for topics where consumers tolerate gaps
+	BackpressureDropOldest = "drop_oldest"
+	// BackpressureSpill hands the event to the spill function (typically an
+	// outbox write) for later relay
+	BackpressureSpill = "spill"
+)
+
+// saturationThreshold is the queue fill ratio above which the queue is
+// considered saturated and the alert fires
+const saturationThreshold = 0.8
+
+// Queue metrics; the depth gauge is the signal to alert on before events
+// are dropped or spilled
+var (
+	publishQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ubmm_publish_queue_depth",
+		Help: "Events waiting in the async publish queue",
+	})
+	publishQueueDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ubmm_publish_queue_dropped_total",
+		Help: "Events dropped by the drop-oldest backpressure policy",
+	})
+	publishQueueSpilled = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ubmm_publish_queue_spilled_total",
+		Help: "Events spilled to the outbox by the spill backpressure policy",
+	})
+	publishQueueSaturated = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ubmm_publish_queue_saturated_total",
+		Help: "Times the publish queue crossed the saturation threshold",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(publishQueueDepth, publishQueueDropped, publishQueueSpilled, publishQueueSaturated)
+}
+
+// SpillFunc persists an event that could not be queued, typically into the
+// outbox table for the relay to deliver later
+type SpillFunc func(ctx context.Context, topic string, event interface{}) error
+
+// queuedEvent is one entry in the publish queue
+type queuedEvent struct {
+	topic string
+	event interface{}
+}
+
+// AsyncPublisher decouples callers from broker pressure with a bounded
+// in-memory queue. A single worker drains the queue into the wrapped
+// publisher; the backpressure policy decides what happens when the queue is
+// full.
+type AsyncPublisher struct {
+	inner  event.Publisher
+	queue  chan queuedEvent
+	policy string
+	spill  SpillFunc
+	logger *zap.Logger
+
+	mu        sync.Mutex
+	saturated bool
+
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+// NewAsyncPublisher creates a new async publisher and starts its worker.
+// spill is required for the spill policy and ignored otherwise.
+func NewAsyncPublisher(inner event.Publisher, size int, policy string, spill SpillFunc, logger *zap.Logger) (*AsyncPublisher, error) {
+	switch policy {
+	case BackpressureBlock, BackpressureDropOldest:
+	case BackpressureSpill:
+		if spill == nil {
+			return nil, fmt.Errorf("spill backpressure policy requires a spill function")
+		}
+	default:
+		return nil, fmt.Errorf("unknown backpressure policy %q", policy)
+	}
+	if size <= 0 {
+		size = 1024
+	}
+
+	p := &AsyncPublisher{
+		inner:  inner,
+		queue:  make(chan queuedEvent, size),
+		policy: policy,
+		spill:  spill,
+		logger: logger,
+	}
+
+	p.wg.Add(1)
+	go p.drain()
+
+	return p, nil
+}
+
+// Publish enqueues the event for asynchronous delivery, applying the
+// backpressure policy when the queue is full
+func (p *AsyncPublisher) Publish(ctx context.Context, topic string, e interface{}) error {
+	entry := queuedEvent{topic: topic, event: e}
+
+	select {
+	case p.queue <- entry:
+		p.observeDepth()
+		return nil
+	default:
+	}
+
+	switch p.policy {
+	case BackpressureBlock:
+		select {
+		case p.queue <- entry:
+			p.observeDepth()
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+	case BackpressureDropOldest:
+		select {
+		case dropped := <-p.queue:
+			publishQueueDropped.Inc()
+			p.logger.Warn("Dropped oldest queued event under backpressure",
+				zap.String("topic", dropped.topic))
+		default:
+		}
+		select {
+		case p.queue <- entry:
+			p.observeDepth()
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+	default: // BackpressureSpill
+		publishQueueSpilled.Inc()
+		if err := p.spill(ctx, topic, e); err != nil {
+			return fmt.Errorf("failed to spill event under backpressure: %w", err)
+		}
+		return nil
+	}
+}
+
+// drain delivers queued events until Close closes the queue
+func (p *AsyncPublisher) drain() {
+	defer p.wg.Done()
+	for entry := range p.queue {
+		publishQueueDepth.Set(float64(len(p.queue)))
+		if err := p.inner.Publish(context.Background(), entry.topic, entry.event); err != nil {
+			p.logger.Error("Failed to publish queued event",
+				zap.String("topic", entry.topic),
+				zap.Error(err))
+		}
+	}
+}
+
+// observeDepth updates the depth gauge and fires the saturation alert when
+// the fill ratio crosses the threshold; a queue that drains back below the
+// threshold re-arms the alert
+func (p *AsyncPublisher) observeDepth() {
+	depth := len(p.queue)
+	publishQueueDepth.Set(float64(depth))
+
+	ratio := float64(depth) / float64(cap(p.queue))
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ratio >= saturationThreshold && !p.saturated {
+		p.saturated = true
+		publishQueueSaturated.Inc()
+		p.logger.Warn("Publish queue saturated",
+			zap.Int("depth", depth),
+			zap.Int("capacity", cap(p.queue)),
+			zap.String("policy", p.policy))
+	} else if ratio < saturationThreshold && p.saturated {
+		p.saturated = false
+	}
+}
+
+// Close stops accepting events, waits for the queue to drain, and returns.
+// Callers close the wrapped publisher themselves afterwards.
+func (p *AsyncPublisher) Close() error {
+	p.once.Do(func() {
+		close(p.queue)
+	})
+	p.wg.Wait()
+	return nil
+}
@@ -0,0 +1,121 @@
+// services/backlog-service/internal/domain/event/asyncapi.go
+
+package event
+
+import "sort"
+
+// asyncAPIVersion is the AsyncAPI specification version the document follows
+const asyncAPIVersion = "2.6.0"
+
+// AsyncAPIDocument builds an AsyncAPI description of the service's Kafka
+// surface from the topic registry and the reflected event schemas. It is
+// generated on demand for the same reason the catalog is: documentation
+// that is computed from the code cannot fall behind it.
+func AsyncAPIDocument() (map[string]interface{}, error) {
+	entries, err := Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	// Group event types by topic; a topic can carry more than one type
+	byTopic := map[string][]CatalogEntry{}
+	for _, entry := range entries {
+		byTopic[entry.Topic] = append(byTopic[entry.Topic], entry)
+	}
+
+	topics := make([]string, 0, len(byTopic))
+	for topic := range byTopic {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	channels := map[string]interface{}{}
+	for _, topic := range topics {
+		topicEntries := byTopic[topic]
+
+		messages := make([]interface{}, 0, len(topicEntries))
+		for _, entry := range topicEntries {
+			messages = append(messages, map[string]interface{}{
+				"name":         string(entry.Type),
+				"title":        string(entry.Type),
+				"contentType":  "application/json",
+				"schemaFormat": "application/schema+json;version=draft-2020-12",
+				"payload":      entry.Schema,
+			})
+		}
+
+		var message interface{}
+		if len(messages) == 1 {
+			message = messages[0]
+		} else {
+			message = map[string]interface{}{"oneOf": messages}
+		}
+
+		channels[topic] = map[string]interface{}{
+			"subscribe": map[string]interface{}{
+				"operationId": "receive_" + topic,
+				"message":     message,
+			},
+			"bindings": map[string]interface{}{
+				"kafka": map[string]interface{}{"bindingVersion": "0.4.0"},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"asyncapi": asyncAPIVersion,
+		"info": map[string]interface{}{
+			"title":       "UBMM Backlog Service Events",
+			"version":     "1.0.0",
+			"description": "Kafka topics published by the backlog service. The compacted state topic backlog.items.state additionally carries the latest full item state keyed by item ID; see the state topic projector.",
+		},
+		"defaultContentType": "application/json",
+		"servers": map[string]interface{}{
+			"kafka": map[string]interface{}{
+				"url":      "kafka:9092",
+				"protocol": "kafka",
+			},
+		},
+		"channels": channels,
+	}, nil
+}
+
+// services/backlog-service/internal/adapters/web/asyncapi.go
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// AsyncAPIPath is where the AsyncAPI description of the Kafka surface is
+// served. Like the event catalog, it is unauthenticated: it describes the
+// contract, not the data.
+const AsyncAPIPath = "/.well-known/ubmm-asyncapi"
+
+// AsyncAPIHandler serves the generated AsyncAPI document
+func AsyncAPIHandler(logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		document, err := event.AsyncAPIDocument()
+		if err != nil {
+			logger.Error("Failed to build AsyncAPI document", zap.Error(err))
+			http.Error(w, "failed to build AsyncAPI document", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(document); err != nil {
+			logger.Error("Failed to encode AsyncAPI document", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,33 @@
+-- services/backlog-service/migrations/000016_auto_expiry.up.sql
+
+-- Per-workspace auto-expiry policy for cold items: untouched for idle_months
+-- and sitting at or below the priority threshold
+CREATE TABLE IF NOT EXISTS expiry_policies (
+    workspace_id UUID PRIMARY KEY,
+    idle_months INTEGER NOT NULL,
+    priority_below INTEGER NOT NULL,
+    action TEXT NOT NULL DEFAULT 'TAG',
+    grace_days INTEGER NOT NULL DEFAULT 14,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One row per item currently inside its grace period. Kept out of
+-- backlog_items so warning bookkeeping never touches updated_at and resets
+-- the idle clock it measures.
+CREATE TABLE IF NOT EXISTS expiry_warnings (
+    item_id UUID PRIMARY KEY REFERENCES backlog_items(id) ON DELETE CASCADE,
+    warned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Per-item opt-out from auto-expiry
+CREATE TABLE IF NOT EXISTS expiry_opt_outs (
+    item_id UUID PRIMARY KEY REFERENCES backlog_items(id) ON DELETE CASCADE,
+    opted_out_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- services/backlog-service/migrations/000016_auto_expiry.down.sql
+
+DROP TABLE IF EXISTS expiry_opt_outs;
+DROP TABLE IF EXISTS expiry_warnings;
+DROP TABLE IF EXISTS expiry_policies;
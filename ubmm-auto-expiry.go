@@ -0,0 +1,243 @@
+// services/backlog-service/internal/domain/service/auto_expiry.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// Expiry actions a policy can take once the grace period runs out
+const (
+	// ExpiryActionTag tags the item "expired" but leaves it open
+	ExpiryActionTag = "TAG"
+	// ExpiryActionClose rejects the item with reason "expired"
+	ExpiryActionClose = "CLOSE"
+)
+
+// expiredTag is the tag the TAG action applies
+const expiredTag = "expired"
+
+// ExpiryPolicy is one workspace's auto-expiry policy: items untouched for
+// IdleMonths sitting at or below the priority threshold are warned, then
+// expired after the grace period
+type ExpiryPolicy struct {
+	WorkspaceID   uuid.UUID `json:"workspaceId"`
+	IdleMonths    int       `json:"idleMonths"`
+	PriorityBelow int       `json:"priorityBelow"`
+	Action        string    `json:"action"`
+	GraceDays     int       `json:"graceDays"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// ExpiryRepository persists expiry policies, grace-period warnings and
+// per-item opt-outs
+type ExpiryRepository interface {
+	// SetExpiryPolicy creates or replaces a workspace's expiry policy
+	SetExpiryPolicy(ctx context.Context, policy *ExpiryPolicy) error
+
+	// GetExpiryPolicy retrieves a workspace's expiry policy, nil when none
+	GetExpiryPolicy(ctx context.Context, workspaceID uuid.UUID) (*ExpiryPolicy, error)
+
+	// ListExpiryPolicies retrieves every configured expiry policy
+	ListExpiryPolicies(ctx context.Context) ([]*ExpiryPolicy, error)
+
+	// ListColdItems retrieves a workspace's pre-commitment items untouched
+	// since idleSince at or below the priority threshold, excluding
+	// opted-out items
+	ListColdItems(ctx context.Context, workspaceID uuid.UUID, idleSince time.Time, priorityBelow int) ([]*model.BacklogItem, error)
+
+	// RecordWarning stores when an item entered its grace period
+	RecordWarning(ctx context.Context, itemID uuid.UUID, warnedAt time.Time) error
+
+	// GetWarning retrieves when an item was warned, nil when it was not
+	GetWarning(ctx context.Context, itemID uuid.UUID) (*time.Time, error)
+
+	// ClearWarning removes an item's grace-period warning
+	ClearWarning(ctx context.Context, itemID uuid.UUID) error
+
+	// OptOut exempts an item from auto-expiry
+	OptOut(ctx context.Context, itemID uuid.UUID, optedOutBy string) error
+
+	// ClearOptOut removes an item's exemption
+	ClearOptOut(ctx context.Context, itemID uuid.UUID) error
+}
+
+// AutoExpiryService enforces per-workspace expiry policies on cold backlog
+// items. Items matching a policy first get a grace-period warning so
+// watchers can rescue them; only items still untouched when the grace period
+// ends are tagged or closed.
+type AutoExpiryService struct {
+	backlog *BacklogService
+	expiry  ExpiryRepository
+	logger  *zap.Logger
+}
+
+// NewAutoExpiryService creates a new auto-expiry service
+func NewAutoExpiryService(backlog *BacklogService, expiry ExpiryRepository, logger *zap.Logger) *AutoExpiryService {
+	return &AutoExpiryService{
+		backlog: backlog,
+		expiry:  expiry,
+		logger:  logger,
+	}
+}
+
+// SetExpiryPolicy creates or replaces a workspace's expiry policy
+func (s *AutoExpiryService) SetExpiryPolicy(ctx context.Context, policy *ExpiryPolicy) error {
+	if policy.IdleMonths <= 0 {
+		return errors.New("idle months must be positive")
+	}
+	if policy.GraceDays <= 0 {
+		return errors.New("grace days must be positive")
+	}
+	if policy.Action != ExpiryActionTag && policy.Action != ExpiryActionClose {
+		return errors.New("expiry action must be TAG or CLOSE")
+	}
+
+	return s.expiry.SetExpiryPolicy(ctx, policy)
+}
+
+// OptOutItem exempts an item from auto-expiry and rescues it from any
+// grace period it is already in
+func (s *AutoExpiryService) OptOutItem(ctx context.Context, itemID uuid.UUID, optedOutBy string) error {
+	if _, err := s.backlog.repo.GetByID(ctx, itemID); err != nil {
+		return err
+	}
+	if err := s.expiry.OptOut(ctx, itemID, optedOutBy); err != nil {
+		return err
+	}
+	return s.expiry.ClearWarning(ctx, itemID)
+}
+
+// ClearOptOut removes an item's exemption from auto-expiry
+func (s *AutoExpiryService) ClearOptOut(ctx context.Context, itemID uuid.UUID) error {
+	return s.expiry.ClearOptOut(ctx, itemID)
+}
+
+// Run applies every workspace's expiry policy once. It is intended to be
+// scheduled daily.
+func (s *AutoExpiryService) Run(ctx context.Context) error {
+	policies, err := s.expiry.ListExpiryPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := s.runPolicy(ctx, policy); err != nil {
+			s.logger.Error("Failed to apply expiry policy",
+				zap.String("workspaceID", policy.WorkspaceID.String()),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// runPolicy warns or expires one workspace's cold items
+func (s *AutoExpiryService) runPolicy(ctx context.Context, policy *ExpiryPolicy) error {
+	now := time.Now().UTC()
+	idleSince := now.AddDate(0, -policy.IdleMonths, 0)
+
+	items, err := s.expiry.ListColdItems(ctx, policy.WorkspaceID, idleSince, policy.PriorityBelow)
+	if err != nil {
+		return err
+	}
+
+	warned, expired := 0, 0
+	for _, item := range items {
+		warnedAt, err := s.expiry.GetWarning(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case warnedAt == nil:
+			// First sighting: start the grace period and notify watchers
+			if err := s.expiry.RecordWarning(ctx, item.ID, now); err != nil {
+				return err
+			}
+			idleDays := now.Sub(item.UpdatedAt).Hours() / 24
+			warningEvent := event.NewItemExpiryWarningEvent(item.ID, idleDays, now.AddDate(0, 0, policy.GraceDays))
+			if err := s.backlog.eventPublisher.Publish(ctx, "backlog.item.expiry_warning", warningEvent); err != nil {
+				s.logger.Error("Failed to publish expiry warning event",
+					zap.String("itemID", item.ID.String()),
+					zap.Error(err))
+			}
+			warned++
+
+		case item.UpdatedAt.After(*warnedAt):
+			// The warning worked: someone touched the item, so it is rescued
+			if err := s.expiry.ClearWarning(ctx, item.ID); err != nil {
+				return err
+			}
+
+		case now.Sub(*warnedAt) >= time.Duration(policy.GraceDays)*24*time.Hour:
+			if err := s.expireItem(ctx, item, policy.Action); err != nil {
+				s.logger.Error("Failed to expire item",
+					zap.String("itemID", item.ID.String()),
+					zap.Error(err))
+				continue
+			}
+			if err := s.expiry.ClearWarning(ctx, item.ID); err != nil {
+				return err
+			}
+			expired++
+		}
+	}
+
+	s.logger.Info("Expiry policy applied",
+		zap.String("workspaceID", policy.WorkspaceID.String()),
+		zap.Int("warned", warned),
+		zap.Int("expired", expired))
+	return nil
+}
+
+// expireItem applies the policy's action to one item and records the
+// expiry event
+func (s *AutoExpiryService) expireItem(ctx context.Context, item *model.BacklogItem, action string) error {
+	switch action {
+	case ExpiryActionTag:
+		tags := append([]string{}, item.Tags...)
+		tags = append(tags, expiredTag)
+		if _, err := s.backlog.UpdateItem(ctx, item.ID, &UpdateItemRequest{Tags: &tags}); err != nil {
+			return err
+		}
+	case ExpiryActionClose:
+		status := model.ItemStatusRejected
+		if _, err := s.backlog.UpdateItem(ctx, item.ID, &UpdateItemRequest{Status: &status}); err != nil {
+			return err
+		}
+	}
+
+	expiredEvent := event.NewItemExpiredEvent(item.ID, action)
+	if err := s.backlog.eventRepo.StoreEvent(ctx, expiredEvent); err != nil {
+		s.logger.Error("Failed to store item expired event", zap.Error(err))
+	}
+	if err := s.backlog.eventPublisher.Publish(ctx, "backlog.item.expired", expiredEvent); err != nil {
+		s.logger.Error("Failed to publish item expired event", zap.Error(err))
+	}
+	return nil
+}
+
+// Start runs the expiry job daily until the context is cancelled
+func (s *AutoExpiryService) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Run(ctx); err != nil {
+				s.logger.Error("Auto-expiry job failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// services/backlog-service/internal/domain/service/backlog_diff.go
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// DiffEntry is one item's appearance in a backlog diff
+type DiffEntry struct {
+	ItemID uuid.UUID      `json:"itemId"`
+	Title  string         `json:"title"`
+	Type   model.ItemType `json:"type"`
+}
+
+// RescopeEntry records a story point change between the two points in time
+type RescopeEntry struct {
+	DiffEntry
+	PointsBefore int `json:"pointsBefore"`
+	PointsAfter  int `json:"pointsAfter"`
+}
+
+// ReprioritizeEntry records a priority change between the two points in time
+type ReprioritizeEntry struct {
+	DiffEntry
+	PriorityBefore int `json:"priorityBefore"`
+	PriorityAfter  int `json:"priorityAfter"`
+}
+
+// BacklogDiff is what changed between two timestamps — the data behind
+// sprint-review "what changed" reports
+type BacklogDiff struct {
+	From          time.Time            `json:"from"`
+	To            time.Time            `json:"to"`
+	Added         []*DiffEntry         `json:"added"`
+	Removed       []*DiffEntry         `json:"removed"`
+	Completed     []*DiffEntry         `json:"completed"`
+	Rescoped      []*RescopeEntry      `json:"rescoped"`
+	Reprioritized []*ReprioritizeEntry `json:"reprioritized"`
+}
+
+// diffItemState is the slice of an event payload's item snapshot the diff
+// cares about
+type diffItemState struct {
+	Item *struct {
+		Title       string           `json:"title"`
+		Type        model.ItemType   `json:"type"`
+		Status      model.ItemStatus `json:"status"`
+		StoryPoints int              `json:"storyPoints"`
+		Priority    int              `json:"priority"`
+	} `json:"item"`
+}
+
+// CompareBacklog computes what changed between two timestamps by walking the
+// event store. The filter restricts the diff to matching item types.
+func (s *BacklogService) CompareBacklog(ctx context.Context, t1, t2 time.Time, types []model.ItemType) (*BacklogDiff, error) {
+	if !t1.Before(t2) {
+		return nil, errors.New("first timestamp must precede the second")
+	}
+
+	records, err := s.eventRepo.GetEventsBetween(ctx, t1, t2)
+	if err != nil {
+		return nil, err
+	}
+
+	typeFilter := make(map[model.ItemType]bool, len(types))
+	for _, t := range types {
+		typeFilter[t] = true
+	}
+
+	// Track each item's first and last observed snapshot inside the window
+	type itemTrack struct {
+		created   bool
+		deleted   bool
+		first     *diffItemState
+		last      *diffItemState
+		firstSeen time.Time
+	}
+	tracks := make(map[uuid.UUID]*itemTrack)
+	order := []uuid.UUID{}
+
+	for _, record := range records {
+		var state diffItemState
+		if err := json.Unmarshal(record.Payload, &state); err != nil || state.Item == nil {
+			continue
+		}
+		if len(typeFilter) > 0 && !typeFilter[state.Item.Type] {
+			continue
+		}
+
+		track, ok := tracks[record.ItemID]
+		if !ok {
+			track = &itemTrack{first: &state, firstSeen: record.CreatedAt}
+			tracks[record.ItemID] = track
+			order = append(order, record.ItemID)
+		}
+		track.last = &state
+
+		switch record.EventType {
+		case string(event.EventTypeItemCreated):
+			track.created = true
+		case string(event.EventTypeItemDeleted):
+			track.deleted = true
+		}
+	}
+
+	diff := &BacklogDiff{From: t1, To: t2}
+	for _, itemID := range order {
+		track := tracks[itemID]
+		entry := DiffEntry{
+			ItemID: itemID,
+			Title:  track.last.Item.Title,
+			Type:   track.last.Item.Type,
+		}
+
+		switch {
+		case track.deleted:
+			diff.Removed = append(diff.Removed, &entry)
+			continue
+		case track.created:
+			diff.Added = append(diff.Added, &entry)
+		}
+
+		if track.last.Item.Status == model.ItemStatusDone && track.first.Item.Status != model.ItemStatusDone {
+			diff.Completed = append(diff.Completed, &entry)
+		}
+		if !track.created && track.first.Item.StoryPoints != track.last.Item.StoryPoints {
+			diff.Rescoped = append(diff.Rescoped, &RescopeEntry{
+				DiffEntry:    entry,
+				PointsBefore: track.first.Item.StoryPoints,
+				PointsAfter:  track.last.Item.StoryPoints,
+			})
+		}
+		if !track.created && track.first.Item.Priority != track.last.Item.Priority {
+			diff.Reprioritized = append(diff.Reprioritized, &ReprioritizeEntry{
+				DiffEntry:      entry,
+				PriorityBefore: track.first.Item.Priority,
+				PriorityAfter:  track.last.Item.Priority,
+			})
+		}
+	}
+
+	return diff, nil
+}
@@ -4,79 +4,341 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
-	
+
 	"github.com/ubmm/backlog-service/internal/domain/model"
 )
 
+// ErrExternalIDConflict is returned when a (system, external ID) pair is
+// already claimed by another backlog item
+var ErrExternalIDConflict = errors.New("external ID is already mapped to another item")
+
 // Repository defines the interface for backlog item persistence
 type BacklogRepository interface {
 	// Create stores a new backlog item
 	Create(ctx context.Context, item *model.BacklogItem) error
-	
+
 	// GetByID retrieves a backlog item by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*model.BacklogItem, error)
-	
+
 	// GetByExternalID retrieves a backlog item by its external ID
 	GetByExternalID(ctx context.Context, system, externalID string) (*model.BacklogItem, error)
-	
+
+	// GetByKey retrieves a backlog item by its human-readable key (e.g. "UB-1234")
+	GetByKey(ctx context.Context, key string) (*model.BacklogItem, error)
+
 	// Update updates an existing backlog item
 	Update(ctx context.Context, item *model.BacklogItem) error
-	
+
 	// Delete deletes a backlog item by its ID
 	Delete(ctx context.Context, id uuid.UUID) error
-	
+
 	// List retrieves backlog items with pagination
 	List(ctx context.Context, filter BacklogFilter) ([]*model.BacklogItem, int64, error)
-	
+
 	// GetChildren retrieves all children of a backlog item
 	GetChildren(ctx context.Context, parentID uuid.UUID) ([]*model.BacklogItem, error)
-	
+
+	// GetAncestors retrieves the ancestor chain of a backlog item, root
+	// first, resolved in a single recursive query
+	GetAncestors(ctx context.Context, id uuid.UUID) ([]*Ancestor, error)
+
+	// ListExternalIDMappings retrieves every external ID mapping for a
+	// system, for integration coverage audits
+	ListExternalIDMappings(ctx context.Context, system string) ([]*ExternalIDMapping, error)
+
 	// UpdatePriorities updates the priorities of multiple items in a batch
 	UpdatePriorities(ctx context.Context, itemPriorities map[uuid.UUID]int) error
+
+	// AggregateItems counts items and sums story points for the filter,
+	// grouped by one of the groupable fields, in a single query
+	AggregateItems(ctx context.Context, filter BacklogFilter, groupBy string) ([]*AggregateBucket, error)
+}
+
+// AggregateBucket is one group in an aggregation result. Key is the grouped
+// value: a status, type, assignee, tag, or root epic ID depending on the
+// grouping; empty for items without the grouped attribute.
+type AggregateBucket struct {
+	Key         string `json:"key"`
+	Count       int    `json:"count"`
+	StoryPoints int    `json:"storyPoints"`
+}
+
+// groupableFields is the whitelist of fields aggregation queries may group
+// by; like sort fields, these arrive from API requests
+var groupableFields = map[string]bool{
+	"status":   true,
+	"type":     true,
+	"assignee": true,
+	"epic":     true,
+	"tag":      true,
+}
+
+// IsGroupableField reports whether aggregation queries may group by the field
+func IsGroupableField(field string) bool {
+	return groupableFields[field]
+}
+
+// ExternalIDMapping is one (system, external ID) -> item link
+type ExternalIDMapping struct {
+	System     string    `json:"system"`
+	ExternalID string    `json:"externalId"`
+	ItemID     uuid.UUID `json:"itemId"`
+}
+
+// Ancestor is one entry in an item's ancestor chain
+type Ancestor struct {
+	ID    uuid.UUID      `json:"id"`
+	Key   string         `json:"key"`
+	Title string         `json:"title"`
+	Type  model.ItemType `json:"type"`
 }
 
 // BacklogFilter defines filters for listing backlog items
 type BacklogFilter struct {
-	Types       []model.ItemType
-	Statuses    []model.ItemStatus
-	Tags        []string
-	ParentID    *uuid.UUID
-	Assignee    string
-	SearchQuery string
-	Limit       int
-	Offset      int
-	SortBy      string
-	SortOrder   string
+	Types          []model.ItemType
+	Statuses       []model.ItemStatus
+	Tags           []string
+	ParentID       *uuid.UUID
+	Assignee       string
+	Reporter       string
+	Sources        []model.ItemSource
+	DueBefore      *time.Time
+	DueAfter       *time.Time
+	OverdueOnly    bool
+	CreatedBefore  *time.Time
+	CreatedAfter   *time.Time
+	UpdatedBefore  *time.Time
+	UpdatedAfter   *time.Time
+	StoryPointsMin *int
+	StoryPointsMax *int
+	NoParent       bool // only items without a parent
+	NoAssignee     bool // only unassigned items
+	IncludeDrafts  bool // drafts are excluded unless set
+	SearchQuery    string
+	Limit          int
+	Offset         int
+	SortBy         string // e.g. "priority", "parent_rank", "created_at", "votes", "cd3"
+	SortOrder      string
+	Sorts          []SortField // multi-column sort; takes precedence over SortBy/SortOrder
+}
+
+// SortField is one column of a multi-column sort. Field must be one of the
+// whitelisted sortable fields; Order is "asc" or "desc" (default asc); Nulls
+// is "first" or "last" (default: the database's own placement).
+type SortField struct {
+	Field string
+	Order string
+	Nulls string
+}
+
+// sortableFields is the whitelist of fields list queries may sort by. Sort
+// parameters come straight from API requests, so anything outside this set is
+// rejected before it reaches SQL.
+var sortableFields = map[string]bool{
+	"priority":     true,
+	"parent_rank":  true,
+	"created_at":   true,
+	"updated_at":   true,
+	"completed_at": true,
+	"story_points": true,
+	"title":        true,
+	"type":         true,
+	"status":       true,
+	"assignee":     true,
+}
+
+// IsSortableField reports whether list queries may sort by the field
+func IsSortableField(field string) bool {
+	return sortableFields[field]
 }
 
 // EventRepository defines the interface for event sourcing
 type EventRepository interface {
 	// StoreEvent stores a domain event
 	StoreEvent(ctx context.Context, event interface{}) error
-	
+
 	// GetEventsByItemID retrieves events for a specific backlog item
 	GetEventsByItemID(ctx context.Context, itemID uuid.UUID) ([]interface{}, error)
-	
+
 	// ReplayEvents replays events to reconstruct state
 	ReplayEvents(ctx context.Context, itemID uuid.UUID) (*model.BacklogItem, error)
+
+	// GetEventsBetween retrieves item events within [from, to) in
+	// chronological order
+	GetEventsBetween(ctx context.Context, from, to time.Time) ([]*ItemEventRecord, error)
+}
+
+// ItemEventRecord is a stored event row as returned by time-range queries
+type ItemEventRecord struct {
+	ItemID    uuid.UUID
+	EventType string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// ActivityRepository defines the interface for the activity feed projection
+type ActivityRepository interface {
+	// AppendActivity appends one entry to the feed
+	AppendActivity(ctx context.Context, entry *ActivityEntry) error
+
+	// ListActivity retrieves feed entries newest first. A zero cursor starts
+	// from the newest entry; otherwise entries older than the cursor are
+	// returned. The returned cursor fetches the next page, zero when the
+	// feed is exhausted.
+	ListActivity(ctx context.Context, workspaceID *uuid.UUID, cursor int64, limit int) ([]*ActivityEntry, int64, error)
+}
+
+// ActivityEntry is one row of the activity feed: a denormalized event with
+// enough item context to render a feed line without further lookups
+type ActivityEntry struct {
+	ID          int64      `json:"id"`
+	WorkspaceID *uuid.UUID `json:"workspaceId,omitempty"`
+	EventType   string     `json:"eventType"`
+	ItemID      uuid.UUID  `json:"itemId"`
+	ItemTitle   string     `json:"itemTitle"`
+	ItemType    string     `json:"itemType"`
+	Actor       string     `json:"actor,omitempty"`
+	OccurredAt  time.Time  `json:"occurredAt"`
 }
 
 // MetricsRepository defines the interface for backlog metrics
 type MetricsRepository interface {
 	// GetBacklogSize retrieves the current backlog size metrics
 	GetBacklogSize(ctx context.Context) (map[model.ItemType]int, error)
-	
+
 	// GetItemAge retrieves age metrics for backlog items
 	GetItemAge(ctx context.Context, status model.ItemStatus) (map[model.ItemType]float64, error)
-	
+
 	// GetWIPCounts retrieves work-in-progress counts
 	GetWIPCounts(ctx context.Context) (int, error)
-	
+
 	// GetLeadTime retrieves lead time metrics
 	GetLeadTime(ctx context.Context, timeWindowDays int) (float64, error)
-	
+
 	// GetThroughput retrieves throughput metrics
 	GetThroughput(ctx context.Context, timeWindowDays int) (int, error)
+
+	// GetOverdueCount retrieves the number of items past their due date
+	GetOverdueCount(ctx context.Context) (int, error)
+
+	// GetLeadTimeBetween retrieves lead time metrics within an explicit
+	// [from, to) window
+	GetLeadTimeBetween(ctx context.Context, from, to time.Time) (float64, error)
+
+	// GetThroughputBetween retrieves throughput within an explicit
+	// [from, to) window
+	GetThroughputBetween(ctx context.Context, from, to time.Time) (int, error)
+
+	// GetWeeklyDemand retrieves items created and points added per week
+	// per item type over the time window
+	GetWeeklyDemand(ctx context.Context, timeWindowWeeks int) (map[model.ItemType]WeeklyRate, error)
+
+	// GetWeeklyCompletion retrieves items completed and points completed
+	// per week per item type over the time window
+	GetWeeklyCompletion(ctx context.Context, timeWindowWeeks int) (map[model.ItemType]WeeklyRate, error)
+}
+
+// WeeklyRate is an average per-week rate of items and story points
+type WeeklyRate struct {
+	ItemsPerWeek  float64 `json:"itemsPerWeek"`
+	PointsPerWeek float64 `json:"pointsPerWeek"`
+}
+
+// RetentionRepository defines the interface for per-workspace data
+// retention policies and their enforcement
+type RetentionRepository interface {
+	// GetRetentionPolicy retrieves a workspace's retention policy, or nil
+	// when none is configured
+	GetRetentionPolicy(ctx context.Context, workspaceID uuid.UUID) (*RetentionPolicy, error)
+
+	// SetRetentionPolicy creates or replaces a workspace's retention policy
+	SetRetentionPolicy(ctx context.Context, policy *RetentionPolicy) error
+
+	// ListRetentionPolicies retrieves every configured retention policy
+	ListRetentionPolicies(ctx context.Context) ([]*RetentionPolicy, error)
+
+	// PurgeExpired deletes (or, under dryRun, only counts) the rows a
+	// workspace's policy has aged out
+	PurgeExpired(ctx context.Context, policy *RetentionPolicy, dryRun bool) (*PurgeReport, error)
+}
+
+// RetentionPolicy holds one workspace's retention windows in days; zero
+// means keep forever
+type RetentionPolicy struct {
+	WorkspaceID       uuid.UUID `json:"workspaceId"`
+	EventsDays        int       `json:"eventsDays"`
+	AuditDays         int       `json:"auditDays"`
+	ActivityDays      int       `json:"activityDays"`
+	ArchivedItemsDays int       `json:"archivedItemsDays"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// PurgeReport counts what one purge run deleted, or would delete under
+// dry run
+type PurgeReport struct {
+	WorkspaceID     uuid.UUID `json:"workspaceId"`
+	DryRun          bool      `json:"dryRun"`
+	Events          int64     `json:"events"`
+	AuditEntries    int64     `json:"auditEntries"`
+	ActivityEntries int64     `json:"activityEntries"`
+	ArchivedItems   int64     `json:"archivedItems"`
+}
+
+// UsageRepository defines the interface for per-workspace usage rollups
+type UsageRepository interface {
+	// IncrementUsage adds delta to a workspace's metric for the given day
+	IncrementUsage(ctx context.Context, workspaceID uuid.UUID, day time.Time, metric string, delta int64) error
+
+	// RecordActiveUser records an actor as active in a workspace on the
+	// given day; repeat calls are no-ops
+	RecordActiveUser(ctx context.Context, workspaceID uuid.UUID, day time.Time, actor string) error
+
+	// GetUsageReport retrieves a workspace's usage within [from, to)
+	GetUsageReport(ctx context.Context, workspaceID uuid.UUID, from, to time.Time) (*UsageReport, error)
+
+	// ListUsageWorkspaces retrieves the workspaces with any usage within
+	// [from, to)
+	ListUsageWorkspaces(ctx context.Context, from, to time.Time) ([]uuid.UUID, error)
+}
+
+// UsageReport is one workspace's usage totals over a period
+type UsageReport struct {
+	WorkspaceID uuid.UUID        `json:"workspaceId"`
+	From        time.Time        `json:"from"`
+	To          time.Time        `json:"to"`
+	Metrics     map[string]int64 `json:"metrics"`
+	ActiveUsers int              `json:"activeUsers"`
+}
+
+// LeaseRepository defines the interface for worker leases, the mutual
+// exclusion primitive that keeps background subsystems from double-running
+// across replicas
+type LeaseRepository interface {
+	// AcquireLease takes the named lease for owner until expiresAt. It
+	// succeeds when the lease is free, expired, or already held by owner,
+	// and reports false when another live owner holds it.
+	AcquireLease(ctx context.Context, name, owner string, expiresAt time.Time) (bool, error)
+
+	// RenewLease extends a lease the owner already holds and reports
+	// whether the lease was still theirs to renew
+	RenewLease(ctx context.Context, name, owner string, expiresAt time.Time) (bool, error)
+
+	// ReleaseLease frees a lease held by owner
+	ReleaseLease(ctx context.Context, name, owner string) error
+
+	// ListLeases retrieves every lease for ownership visibility
+	ListLeases(ctx context.Context) ([]*WorkerLease, error)
+}
+
+// WorkerLease is one lease row: which replica currently owns a named piece
+// of background work
+type WorkerLease struct {
+	Name       string    `json:"name"`
+	Owner      string    `json:"owner"`
+	AcquiredAt time.Time `json:"acquiredAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/ubmm/backlog-service/internal/adapters/cache"
 	"github.com/ubmm/backlog-service/internal/adapters/grpc"
 	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/model"
 	"github.com/ubmm/backlog-service/internal/domain/service"
 )
 
@@ -41,6 +42,13 @@ func main() {
 		logger.Fatal("Failed to load configuration", zap.Error(err))
 	}
 
+	// Apply the workspace's estimation scale before serving traffic
+	scale, err := model.EstimationScaleForName(cfg.Estimation.Scale)
+	if err != nil {
+		logger.Fatal("Invalid estimation scale", zap.Error(err))
+	}
+	model.SetEstimationScale(scale)
+
 	// Initialize database
 	dbAdapter, err := db.NewPostgresAdapter(cfg.Database)
 	if err != nil {
@@ -142,6 +150,7 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -157,12 +166,46 @@ const (
 	ItemTypeFeature ItemType = "FEATURE"
 	// ItemTypeStory represents a user story
 	ItemTypeStory ItemType = "STORY"
+	// ItemTypeSpike represents a timeboxed research item
+	ItemTypeSpike ItemType = "SPIKE"
+	// ItemTypeTask represents a subtask under a story
+	ItemTypeTask ItemType = "TASK"
+)
+
+// ItemSource defines where a backlog item originated
+type ItemSource string
+
+const (
+	// ItemSourceAPI represents an item created through the API
+	ItemSourceAPI ItemSource = "api"
+	// ItemSourceImport represents an item created by a bulk import
+	ItemSourceImport ItemSource = "import"
+	// ItemSourceJiraSync represents an item created by Jira sync
+	ItemSourceJiraSync ItemSource = "jira-sync"
+	// ItemSourceEmail represents an item created from an inbound email
+	ItemSourceEmail ItemSource = "email"
+	// ItemSourceSlack represents an item created from Slack
+	ItemSourceSlack ItemSource = "slack"
+)
+
+// EstimateConfidence expresses how much the team trusts an estimate
+type EstimateConfidence string
+
+const (
+	// ConfidenceLow represents a rough guess
+	ConfidenceLow EstimateConfidence = "LOW"
+	// ConfidenceMedium represents an informed estimate
+	ConfidenceMedium EstimateConfidence = "MEDIUM"
+	// ConfidenceHigh represents a well-understood, refined estimate
+	ConfidenceHigh EstimateConfidence = "HIGH"
 )
 
 // ItemStatus defines the status of backlog item
 type ItemStatus string
 
 const (
+	// ItemStatusIntake represents an untriaged item in the intake queue
+	ItemStatusIntake ItemStatus = "INTAKE"
 	// ItemStatusNew represents a newly created item
 	ItemStatusNew ItemStatus = "NEW"
 	// ItemStatusReady represents an item ready for sprint
@@ -173,20 +216,44 @@ const (
 	ItemStatusDone ItemStatus = "DONE"
 	// ItemStatusBlocked represents a blocked item
 	ItemStatusBlocked ItemStatus = "BLOCKED"
+	// ItemStatusRejected represents an item rejected during triage
+	ItemStatusRejected ItemStatus = "REJECTED"
 )
 
 // BacklogItem represents a backlog item (epic, feature, or story)
 type BacklogItem struct {
 	ID          uuid.UUID  `json:"id"`
+	Key         string     `json:"key"` // human-readable key, e.g. "UB-1234"; assigned by the database
 	Type        ItemType   `json:"type"`
 	ParentID    *uuid.UUID `json:"parentId"`
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
 	StoryPoints int        `json:"storyPoints"`
+	Confidence  EstimateConfidence `json:"confidence"`
 	Status      ItemStatus `json:"status"`
-	Priority    int        `json:"priority"`
+	Priority    int        `json:"priority"`   // global backlog rank
+	ParentRank  int        `json:"parentRank"` // rank among siblings under the same parent
 	Assignee    string     `json:"assignee"`
+	Reporter    string     `json:"reporter"`
+	Source      ItemSource `json:"source"`
 	Tags        []string   `json:"tags"`
+	DueDate     *time.Time `json:"dueDate"`
+	CostOfDelayPerWeek    float64 `json:"costOfDelayPerWeek"`    // in workspace currency
+	DurationEstimateWeeks float64 `json:"durationEstimateWeeks"`
+	Budget                float64 `json:"budget"` // epic delivery budget in workspace currency
+	TimeboxHours          float64    `json:"timeboxHours"`     // spike timebox instead of story points
+	TimeboxStartedAt      *time.Time `json:"timeboxStartedAt"` // set when a spike moves to IN_PROGRESS
+	Outcome               string     `json:"outcome"`          // spike research outcome, required before DONE
+	Style                 *ItemStyle `json:"style,omitempty"`  // card presentation metadata
+	StartedAt    *time.Time `json:"startedAt"`    // first transition into IN_PROGRESS
+	CompletedAt  *time.Time `json:"completedAt"`  // transition into DONE
+	BlockedSince *time.Time `json:"blockedSince"` // set while the item is BLOCKED
+	BlockedReason string     `json:"blockedReason,omitempty"` // required while the item is BLOCKED
+	BlockedByID   *uuid.UUID `json:"blockedById,omitempty"`   // optional reference to the blocking item
+	Draft         bool       `json:"draft"` // visible only to the author until published
+	StalenessScore float64   `json:"stalenessScore,omitempty"` // heuristic never-completed probability; computed on read, not stored
+	EngagementScore float64  `json:"engagementScore,omitempty"` // watcher/comment/vote engagement signal; computed on read, not stored
+	EscalationLevel int      `json:"escalationLevel,omitempty"` // rung of the aging escalation ladder reached; attached on read, not stored
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
 	ExternalIDs map[string]string `json:"externalIds"` // Map of external system IDs (e.g., "jira": "PROJ-123")
@@ -210,6 +277,7 @@ func NewBacklogItem(itemType ItemType, title, description string) (*BacklogItem,
 		Description: description,
 		Status:      ItemStatusNew,
 		Priority:    0,
+		Source:      ItemSourceAPI,
 		Tags:        []string{},
 		CreatedAt:   now,
 		UpdatedAt:   now,
@@ -217,6 +285,15 @@ func NewBacklogItem(itemType ItemType, title, description string) (*BacklogItem,
 	}, nil
 }
 
+// SetProvenance records who filed the item and where it originated
+func (i *BacklogItem) SetProvenance(reporter string, source ItemSource) {
+	i.Reporter = reporter
+	if source != "" {
+		i.Source = source
+	}
+	i.UpdatedAt = time.Now().UTC()
+}
+
 // UpdateTitle updates the item title
 func (i *BacklogItem) UpdateTitle(title string) error {
 	if title == "" {
@@ -238,38 +315,208 @@ func (i *BacklogItem) UpdateStatus(status ItemStatus) error {
 	if !isValidItemStatus(status) {
 		return errors.New("invalid item status")
 	}
+	// Spikes must record what the research found before closing
+	if status == ItemStatusDone && i.Type == ItemTypeSpike && i.Outcome == "" {
+		return errors.New("spike outcome must be recorded before completion")
+	}
+	// Start the spike's timebox clock when work begins
+	if status == ItemStatusInProgress && i.Type == ItemTypeSpike && i.TimeboxStartedAt == nil {
+		now := time.Now().UTC()
+		i.TimeboxStartedAt = &now
+	}
+
+	// Maintain transition timestamps on the row so lead time does not
+	// depend on updated_at, which moves on every edit
+	now := time.Now().UTC()
+	if status == ItemStatusInProgress && i.StartedAt == nil {
+		i.StartedAt = &now
+	}
+	if status == ItemStatusDone {
+		i.CompletedAt = &now
+	} else {
+		i.CompletedAt = nil
+	}
+	if status == ItemStatusBlocked {
+		if i.BlockedReason == "" {
+			return errors.New("a reason is required to block an item")
+		}
+		if i.BlockedSince == nil {
+			i.BlockedSince = &now
+		}
+	} else {
+		i.BlockedSince = nil
+		i.BlockedReason = ""
+		i.BlockedByID = nil
+	}
+
 	i.Status = status
-	i.UpdatedAt = time.Now().UTC()
+	i.UpdatedAt = now
 	return nil
 }
 
+// Block transitions the item to BLOCKED with the required reason and an
+// optional reference to the item blocking it
+func (i *BacklogItem) Block(reason string, blockedBy *uuid.UUID) error {
+	if reason == "" {
+		return errors.New("blocked reason cannot be empty")
+	}
+	i.BlockedReason = reason
+	i.BlockedByID = blockedBy
+	return i.UpdateStatus(ItemStatusBlocked)
+}
+
 // UpdateStoryPoints updates story points
 func (i *BacklogItem) UpdateStoryPoints(points int) error {
+	if i.Type == ItemTypeSpike {
+		return errors.New("spikes are timeboxed, not estimated in points")
+	}
 	if points < 0 {
 		return errors.New("story points cannot be negative")
 	}
+	if scale := ActiveEstimationScale(); !scale.IsValid(points) {
+		below, above := scale.Nearest(points)
+		return fmt.Errorf("%d is not on the %s scale; nearest valid values are %d and %d", points, scale.Name, below, above)
+	}
 	i.StoryPoints = points
 	i.UpdatedAt = time.Now().UTC()
 	return nil
 }
 
+// UpdateConfidence updates the estimate confidence
+func (i *BacklogItem) UpdateConfidence(confidence EstimateConfidence) error {
+	switch confidence {
+	case ConfidenceLow, ConfidenceMedium, ConfidenceHigh:
+	default:
+		return errors.New("invalid estimate confidence")
+	}
+	i.Confidence = confidence
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 // UpdatePriority updates the item priority
 func (i *BacklogItem) UpdatePriority(priority int) {
 	i.Priority = priority
 	i.UpdatedAt = time.Now().UTC()
 }
 
+// UpdateParentRank updates the item's rank among its siblings
+func (i *BacklogItem) UpdateParentRank(rank int) {
+	i.ParentRank = rank
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// MarkAsDraft hides the item from default lists and metrics until published
+func (i *BacklogItem) MarkAsDraft() {
+	i.Draft = true
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// Publish makes a draft item visible to the whole workspace
+func (i *BacklogItem) Publish() error {
+	if !i.Draft {
+		return errors.New("item is not a draft")
+	}
+	i.Draft = false
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 // UpdateParent links the item to a parent
 func (i *BacklogItem) UpdateParent(parentID *uuid.UUID) error {
-	// Validate parent-child relationship based on item type
-	if parentID != nil && i.Type == ItemTypeEpic {
-		return errors.New("epic cannot have a parent")
+	// Validate parent-child relationship based on item type: top-of-hierarchy
+	// types (epics by default) cannot have a parent
+	if parentID != nil && !activeRegistry.HasParentType(i.Type) {
+		return errors.New("item type cannot have a parent")
 	}
 	i.ParentID = parentID
 	i.UpdatedAt = time.Now().UTC()
 	return nil
 }
 
+// UpdateCostOfDelay sets the cost of delay and duration estimate used for
+// CD3 prioritization
+func (i *BacklogItem) UpdateCostOfDelay(costPerWeek, durationWeeks float64) error {
+	if costPerWeek < 0 {
+		return errors.New("cost of delay cannot be negative")
+	}
+	if durationWeeks < 0 {
+		return errors.New("duration estimate cannot be negative")
+	}
+	i.CostOfDelayPerWeek = costPerWeek
+	i.DurationEstimateWeeks = durationWeeks
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// CD3 returns CoD divided by duration (cost of delay divided by duration),
+// or 0 when no duration estimate is set
+func (i *BacklogItem) CD3() float64 {
+	if i.DurationEstimateWeeks <= 0 {
+		return 0
+	}
+	return i.CostOfDelayPerWeek / i.DurationEstimateWeeks
+}
+
+// SetTimebox sets a spike's timebox in hours
+func (i *BacklogItem) SetTimebox(hours float64) error {
+	if i.Type != ItemTypeSpike {
+		return errors.New("only spikes have a timebox")
+	}
+	if hours <= 0 {
+		return errors.New("timebox must be positive")
+	}
+	i.TimeboxHours = hours
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// RecordOutcome records what a spike's research found
+func (i *BacklogItem) RecordOutcome(outcome string) error {
+	if i.Type != ItemTypeSpike {
+		return errors.New("only spikes record an outcome")
+	}
+	if outcome == "" {
+		return errors.New("outcome cannot be empty")
+	}
+	i.Outcome = outcome
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// TimeboxElapsed checks if a started spike has used up its timebox
+func (i *BacklogItem) TimeboxElapsed(now time.Time) bool {
+	if i.Type != ItemTypeSpike || i.TimeboxStartedAt == nil || i.TimeboxHours <= 0 {
+		return false
+	}
+	return i.Status == ItemStatusInProgress &&
+		now.Sub(*i.TimeboxStartedAt).Hours() >= i.TimeboxHours
+}
+
+// UpdateBudget sets the delivery budget; only epics carry budgets
+func (i *BacklogItem) UpdateBudget(budget float64) error {
+	if i.Type != ItemTypeEpic {
+		return errors.New("only epics can have a budget")
+	}
+	if budget < 0 {
+		return errors.New("budget cannot be negative")
+	}
+	i.Budget = budget
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// UpdateDueDate sets or clears the item due date
+func (i *BacklogItem) UpdateDueDate(dueDate *time.Time) {
+	i.DueDate = dueDate
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// IsOverdue checks if the item is past its due date and not done
+func (i *BacklogItem) IsOverdue(now time.Time) bool {
+	return i.DueDate != nil && now.After(*i.DueDate) && i.Status != ItemStatusDone
+}
+
 // AddTag adds a tag to the item
 func (i *BacklogItem) AddTag(tag string) {
 	for _, existingTag := range i.Tags {
@@ -303,6 +550,12 @@ func (i *BacklogItem) GetExternalID(system string) string {
 	return i.ExternalIDs[system]
 }
 
+// RemoveExternalID removes an external system ID mapping
+func (i *BacklogItem) RemoveExternalID(system string) {
+	delete(i.ExternalIDs, system)
+	i.UpdatedAt = time.Now().UTC()
+}
+
 // IsReady checks if item is ready to be worked on
 func (i *BacklogItem) IsReady() bool {
 	return i.Status == ItemStatusReady
@@ -310,13 +563,9 @@ func (i *BacklogItem) IsReady() bool {
 
 // Helper functions
 func isValidItemType(itemType ItemType) bool {
-	return itemType == ItemTypeEpic || itemType == ItemTypeFeature || itemType == ItemTypeStory
+	return activeRegistry.IsValid(itemType)
 }
 
 func isValidItemStatus(status ItemStatus) bool {
-	return status == ItemStatusNew || 
-		status == ItemStatusReady || 
-		status == ItemStatusInProgress || 
-		status == ItemStatusDone || 
-		status == ItemStatusBlocked
+	return activeWorkflow.IsValid(status)
 }
@@ -5,6 +5,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -59,6 +60,16 @@ func (s *BacklogService) CreateItem(ctx context.Context, req *CreateItemRequest)
 		return nil, err
 	}
 
+	// Record provenance: who filed the item and through which channel
+	reporter, source := ProvenanceFromContext(ctx)
+	if req.Reporter != "" {
+		reporter = req.Reporter
+	}
+	if req.Source != "" {
+		source = req.Source
+	}
+	item.SetProvenance(reporter, source)
+
 	// Set additional properties
 	if req.ParentID != nil {
 		err = item.UpdateParent(req.ParentID)
@@ -87,11 +98,22 @@ func (s *BacklogService) CreateItem(ctx context.Context, req *CreateItemRequest)
 		}
 	}
 
+	if req.Confidence != "" {
+		err = item.UpdateConfidence(req.Confidence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Add tags
 	for _, tag := range req.Tags {
 		item.AddTag(tag)
 	}
 
+	if req.Draft {
+		item.MarkAsDraft()
+	}
+
 	// Persist the item
 	err = s.repo.Create(ctx, item)
 	if err != nil {
@@ -143,6 +165,72 @@ func (s *BacklogService) GetItem(ctx context.Context, id uuid.UUID) (*model.Back
 	return item, nil
 }
 
+// GetItemByKey retrieves a backlog item by its human-readable key. Keys are
+// the UUIDs' conversational alias (e.g. "UB-1234"); the UUID stays the
+// primary identifier everywhere else.
+func (s *BacklogService) GetItemByKey(ctx context.Context, key string) (*model.BacklogItem, error) {
+	// Try to get from cache first
+	cacheKey := "itemkey:" + key
+	if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != nil {
+		if item, ok := cached.(*model.BacklogItem); ok {
+			return item, nil
+		}
+	}
+
+	item, err := s.repo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the item
+	if err := s.cache.Set(ctx, cacheKey, item, 5*time.Minute); err != nil {
+		s.logger.Error("Failed to cache item", zap.Error(err))
+	}
+
+	return item, nil
+}
+
+// GetItemByExternalID retrieves a backlog item by one of its external system
+// mappings, e.g. the Jira issue key a sync integration attached
+func (s *BacklogService) GetItemByExternalID(ctx context.Context, system, externalID string) (*model.BacklogItem, error) {
+	// Try to get from cache first
+	cacheKey := "extid:" + system + ":" + externalID
+	if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != nil {
+		if item, ok := cached.(*model.BacklogItem); ok {
+			return item, nil
+		}
+	}
+
+	item, err := s.repo.GetByExternalID(ctx, system, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the item
+	if err := s.cache.Set(ctx, cacheKey, item, 5*time.Minute); err != nil {
+		s.logger.Error("Failed to cache item", zap.Error(err))
+	}
+
+	return item, nil
+}
+
+// parseExternalIDQuery interprets "system=id" search queries, e.g.
+// "jira=PROJ-123". The system name must be lowercase alphanumeric so normal
+// search text containing "=" is not misread as a lookup.
+func parseExternalIDQuery(query string) (string, string, bool) {
+	idx := strings.Index(query, "=")
+	if idx <= 0 || idx == len(query)-1 {
+		return "", "", false
+	}
+	system := query[:idx]
+	for _, r := range system {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return "", "", false
+		}
+	}
+	return system, query[idx+1:], true
+}
+
 // UpdateItem updates an existing backlog item
 func (s *BacklogService) UpdateItem(ctx context.Context, id uuid.UUID, req *UpdateItemRequest) (*model.BacklogItem, error) {
 	// Get the existing item
@@ -177,6 +265,13 @@ func (s *BacklogService) UpdateItem(ctx context.Context, id uuid.UUID, req *Upda
 		}
 	}
 
+	if req.Confidence != nil {
+		err = item.UpdateConfidence(*req.Confidence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Priority != nil {
 		item.UpdatePriority(*req.Priority)
 	}
@@ -195,16 +290,53 @@ func (s *BacklogService) UpdateItem(ctx context.Context, id uuid.UUID, req *Upda
 			}
 		}
 
+		moved := (item.ParentID == nil && *req.ParentID != uuid.Nil) ||
+			(item.ParentID != nil && *item.ParentID != *req.ParentID)
+
 		err = item.UpdateParent(req.ParentID)
 		if err != nil {
 			return nil, err
 		}
+
+		// The moved item's breadcrumb is stale; its descendants' cached
+		// chains age out on their TTL
+		if moved {
+			s.cache.Delete(ctx, "ancestors:"+item.ID.String())
+		}
+
+		// An item moved under a new parent ranks after its new siblings
+		if moved && *req.ParentID != uuid.Nil {
+			siblings, err := s.repo.GetChildren(ctx, *req.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			maxRank := 0
+			for _, sibling := range siblings {
+				if sibling.ParentRank > maxRank {
+					maxRank = sibling.ParentRank
+				}
+			}
+			item.UpdateParentRank(maxRank + 1)
+		}
 	}
 
 	if req.Assignee != nil {
 		item.Assignee = *req.Assignee
 	}
 
+	if req.DueDate != nil {
+		item.UpdateDueDate(req.DueDate)
+	} else if req.ClearDueDate {
+		item.UpdateDueDate(nil)
+	}
+
+	if req.Style != nil {
+		err = item.UpdateStyle(req.Style)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update tags if provided
 	if req.Tags != nil {
 		// Clear existing tags and add new ones
@@ -286,6 +418,17 @@ func (s *BacklogService) DeleteItem(ctx context.Context, id uuid.UUID) error {
 
 // ListItems lists backlog items with filtering
 func (s *BacklogService) ListItems(ctx context.Context, filter repository.BacklogFilter) ([]*model.BacklogItem, int64, error) {
+	// "system=id" search queries (e.g. "jira=PROJ-123") are external ID lookups
+	if system, externalID, ok := parseExternalIDQuery(filter.SearchQuery); ok {
+		item, err := s.GetItemByExternalID(ctx, system, externalID)
+		if err != nil {
+			// No mapping is an empty result, not an error, so search UIs
+			// degrade gracefully
+			return []*model.BacklogItem{}, 0, nil
+		}
+		return []*model.BacklogItem{item}, 1, nil
+	}
+
 	// Try to get from cache if no search query
 	if filter.SearchQuery == "" {
 		cacheKey := buildListCacheKey(filter)
@@ -345,6 +488,60 @@ func (s *BacklogService) GetChildren(ctx context.Context, parentID uuid.UUID) ([
 	return children, nil
 }
 
+// GetAncestors retrieves the ancestor chain of a backlog item, root first,
+// for breadcrumb rendering. The chain is resolved in one recursive query and
+// cached; after a re-parent, descendants' cached chains may lag by the TTL.
+func (s *BacklogService) GetAncestors(ctx context.Context, id uuid.UUID) ([]*repository.Ancestor, error) {
+	// Try to get from cache
+	cacheKey := "ancestors:" + id.String()
+	cachedResult, err := s.cache.Get(ctx, cacheKey)
+	if err == nil && cachedResult != nil {
+		if ancestors, ok := cachedResult.([]*repository.Ancestor); ok {
+			return ancestors, nil
+		}
+	}
+
+	// Get from repository
+	ancestors, err := s.repo.GetAncestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the result
+	err = s.cache.Set(ctx, cacheKey, ancestors, 5*time.Minute)
+	if err != nil {
+		s.logger.Error("Failed to cache ancestors", zap.Error(err))
+	}
+
+	return ancestors, nil
+}
+
+// ListChildren retrieves the children of a backlog item with filtering and
+// pagination, so parents with large child sets can be fetched a page at a
+// time. GetChildren remains for internal callers that need the full set.
+func (s *BacklogService) ListChildren(ctx context.Context, parentID uuid.UUID, filter repository.BacklogFilter) ([]*model.BacklogItem, int64, error) {
+	filter.ParentID = &parentID
+	if filter.SortBy == "" {
+		filter.SortBy = "parent_rank"
+	}
+	if filter.Limit <= 0 || filter.Limit > 200 {
+		filter.Limit = 50
+	}
+
+	return s.repo.List(ctx, filter)
+}
+
+// AggregateItems counts items and sums story points for the filter, grouped
+// by status, type, assignee, epic, or tag, so summary views need one query
+// instead of the full item list
+func (s *BacklogService) AggregateItems(ctx context.Context, filter repository.BacklogFilter, groupBy string) ([]*repository.AggregateBucket, error) {
+	if !repository.IsGroupableField(groupBy) {
+		return nil, fmt.Errorf("cannot group by %q", groupBy)
+	}
+
+	return s.repo.AggregateItems(ctx, filter, groupBy)
+}
+
 // ReorderItems reorders backlog items by updating their priorities
 func (s *BacklogService) ReorderItems(ctx context.Context, reorderRequests []ReorderRequest) error {
 	if len(reorderRequests) == 0 {
@@ -382,6 +579,82 @@ func (s *BacklogService) ReorderItems(ctx context.Context, reorderRequests []Reo
 	return nil
 }
 
+// MigrateStatus moves every item on one status to another, then removes the
+// old status from the workflow. This is the safe path for editing or
+// deleting a custom status: no item is ever left on an unknown status.
+func (s *BacklogService) MigrateStatus(ctx context.Context, from, to model.ItemStatus) (int, error) {
+	workflow := model.ActiveWorkflow()
+	if !workflow.IsValid(to) {
+		return 0, errors.New("target status is not registered")
+	}
+
+	migrated := 0
+	for {
+		items, _, err := s.repo.List(ctx, repository.BacklogFilter{
+			Statuses: []model.ItemStatus{from},
+			Limit:    200,
+		})
+		if err != nil {
+			return migrated, err
+		}
+		if len(items) == 0 {
+			break
+		}
+		for _, item := range items {
+			if err := item.UpdateStatus(to); err != nil {
+				return migrated, err
+			}
+			if err := s.repo.Update(ctx, item); err != nil {
+				return migrated, err
+			}
+			s.cache.Delete(ctx, "item:"+item.ID.String())
+			migrated++
+		}
+	}
+
+	if err := workflow.Remove(from); err != nil {
+		// Built-in statuses stay registered; migrating off them is still valid
+		s.logger.Info("Status kept in workflow after migration",
+			zap.String("status", string(from)),
+			zap.Error(err))
+	}
+
+	s.invalidateListCache(ctx)
+	return migrated, nil
+}
+
+// ReorderChildren re-ranks the children of a parent. Ranks are assigned in
+// the order the IDs are given; the global priority is left untouched.
+func (s *BacklogService) ReorderChildren(ctx context.Context, parentID uuid.UUID, orderedIDs []uuid.UUID) error {
+	children, err := s.repo.GetChildren(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[uuid.UUID]*model.BacklogItem, len(children))
+	for _, child := range children {
+		byID[child.ID] = child
+	}
+
+	for rank, childID := range orderedIDs {
+		child, ok := byID[childID]
+		if !ok {
+			return errors.New("item is not a child of the given parent")
+		}
+		child.UpdateParentRank(rank + 1)
+		if err := s.repo.Update(ctx, child); err != nil {
+			return err
+		}
+		s.cache.Delete(ctx, "item:"+childID.String())
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "children:"+parentID.String())
+	s.invalidateListCache(ctx)
+
+	return nil
+}
+
 // SetExternalID sets an external system ID for a backlog item
 func (s *BacklogService) SetExternalID(ctx context.Context, id uuid.UUID, system, externalID string) error {
 	// Get the existing item
@@ -412,12 +685,96 @@ func (s *BacklogService) SetExternalID(ctx context.Context, id uuid.UUID, system
 		s.logger.Error("Failed to publish external ID event", zap.Error(err))
 	}
 
-	// Invalidate item cache
+	// Invalidate item and reverse-lookup caches
 	s.cache.Delete(ctx, "item:"+id.String())
+	s.cache.Delete(ctx, "extid:"+system+":"+externalID)
 
 	return nil
 }
 
+// RemoveExternalID removes an external system ID mapping from a backlog
+// item, so admins can fix bad integration links
+func (s *BacklogService) RemoveExternalID(ctx context.Context, id uuid.UUID, system string) error {
+	// Get the existing item
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	externalID := item.GetExternalID(system)
+	if externalID == "" {
+		return errors.New("item has no external ID for this system")
+	}
+
+	// Remove the external ID
+	item.RemoveExternalID(system)
+
+	// Persist the updated item
+	err = s.repo.Update(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	// Store event
+	removedEvent := event.NewExternalIDRemovedEvent(item.ID, system, externalID)
+	err = s.eventRepo.StoreEvent(ctx, removedEvent)
+	if err != nil {
+		s.logger.Error("Failed to store external ID removed event", zap.Error(err))
+	}
+
+	// Publish event
+	err = s.eventPublisher.Publish(ctx, "backlog.item.external_id.removed", removedEvent)
+	if err != nil {
+		s.logger.Error("Failed to publish external ID removed event", zap.Error(err))
+	}
+
+	// Invalidate item and reverse-lookup caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.cache.Delete(ctx, "extid:"+system+":"+externalID)
+
+	return nil
+}
+
+// ListExternalIDMappings retrieves every external ID mapping for a system,
+// so integration coverage can be audited
+func (s *BacklogService) ListExternalIDMappings(ctx context.Context, system string) ([]*repository.ExternalIDMapping, error) {
+	return s.repo.ListExternalIDMappings(ctx, system)
+}
+
+// SetCostOfDelay updates an item's cost of delay and duration estimate and
+// records the change for economic prioritization audits
+func (s *BacklogService) SetCostOfDelay(ctx context.Context, id uuid.UUID, costPerWeek, durationWeeks float64) (*model.BacklogItem, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prevCost := item.CostOfDelayPerWeek
+	prevDuration := item.DurationEstimateWeeks
+	if err := item.UpdateCostOfDelay(costPerWeek, durationWeeks); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	// Store and publish the change for audit history
+	codEvent := event.NewItemCostOfDelayChangedEvent(item.ID, prevCost, costPerWeek, prevDuration, durationWeeks, item.CD3())
+	if err := s.eventRepo.StoreEvent(ctx, codEvent); err != nil {
+		s.logger.Error("Failed to store cost of delay event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.cost_of_delay.changed", codEvent); err != nil {
+		s.logger.Error("Failed to publish cost of delay event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.invalidateListCache(ctx)
+
+	return item, nil
+}
+
 // GetMetrics retrieves backlog metrics
 func (s *BacklogService) GetMetrics(ctx context.Context) (*BacklogMetrics, error) {
 	// Try to get from cache
@@ -459,6 +816,18 @@ func (s *BacklogService) GetMetrics(ctx context.Context) (*BacklogMetrics, error
 		return nil, err
 	}
 
+	// Get overdue count
+	overdueCount, err := s.metricsRepo.GetOverdueCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get refinement state
+	refinement, err := s.GetRefinementReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build metrics response
 	metrics := &BacklogMetrics{
 		TotalItems:    sizeCounts[model.ItemTypeEpic] + sizeCounts[model.ItemTypeFeature] + sizeCounts[model.ItemTypeStory],
@@ -469,8 +838,10 @@ func (s *BacklogService) GetMetrics(ctx context.Context) (*BacklogMetrics, error
 		WIPCount:      wipCount,
 		LeadTimeDays:  leadTime,
 		ThroughputLast30Days: throughput,
+		OverdueCount:  overdueCount,
 		IcebergRatio:  calculateIcebergRatio(sizeCounts),
-		HealthStatus:  determineHealthStatus(sizeCounts, wipCount, leadTime),
+		UnrefinedPercentage: refinement.UnrefinedPercentage,
+		HealthStatus:  determineHealthStatus(sizeCounts, wipCount, leadTime, refinement.UnrefinedPercentage),
 	}
 
 	// Cache the result
@@ -485,13 +856,7 @@ func (s *BacklogService) GetMetrics(ctx context.Context) (*BacklogMetrics, error
 // Helper functions
 
 func isValidParentChild(parentType, childType model.ItemType) bool {
-	if parentType == model.ItemTypeEpic && childType == model.ItemTypeFeature {
-		return true
-	}
-	if parentType == model.ItemTypeFeature && childType == model.ItemTypeStory {
-		return true
-	}
-	return false
+	return model.ActiveTypeRegistry().IsValidParentChild(parentType, childType)
 }
 
 func (s *BacklogService) invalidateListCache(ctx context.Context) {
@@ -539,20 +904,20 @@ func calculateIcebergRatio(sizeCounts map[model.ItemType]int) float64 {
 	return 1.0 - (deviation / 2.0)
 }
 
-func determineHealthStatus(sizeCounts map[model.ItemType]int, wipCount int, leadTime float64) string {
+func determineHealthStatus(sizeCounts map[model.ItemType]int, wipCount int, leadTime float64, unrefinedPct float64) string {
 	totalItems := sizeCounts[model.ItemTypeEpic] + sizeCounts[model.ItemTypeFeature] + sizeCounts[model.ItemTypeStory]
-	
+
 	// Health criteria
 	if totalItems > 150 {
 		return "AT_RISK"
 	}
-	if wipCount > 20 || leadTime > 60 {
+	if wipCount > 20 || leadTime > 60 || unrefinedPct > 50 {
 		return "WARNING"
 	}
-	if totalItems <= 100 && wipCount <= 10 && leadTime <= 30 {
+	if totalItems <= 100 && wipCount <= 10 && leadTime <= 30 && unrefinedPct <= 25 {
 		return "HEALTHY"
 	}
-	
+
 	return "AVERAGE"
 }
 
@@ -571,8 +936,12 @@ type CreateItemRequest struct {
 	Description string
 	ParentID    *uuid.UUID
 	StoryPoints int
+	Confidence  model.EstimateConfidence
 	Tags        []string
 	Assignee    string
+	Reporter    string
+	Source      model.ItemSource
+	Draft       bool
 }
 
 type UpdateItemRequest struct {
@@ -581,9 +950,13 @@ type UpdateItemRequest struct {
 	Status      *model.ItemStatus
 	ParentID    *uuid.UUID
 	StoryPoints *int
+	Confidence  *model.EstimateConfidence
 	Priority    *int
 	Assignee    *string
 	Tags        *[]string
+	DueDate     *time.Time
+	ClearDueDate bool
+	Style       *model.ItemStyle
 }
 
 type ReorderRequest struct {
@@ -600,7 +973,9 @@ type BacklogMetrics struct {
 	WIPCount             int     `json:"wipCount"`
 	LeadTimeDays         float64 `json:"leadTimeDays"`
 	ThroughputLast30Days int     `json:"throughputLast30Days"`
+	OverdueCount         int     `json:"overdueCount"`
 	IcebergRatio         float64 `json:"icebergRatio"`
+	UnrefinedPercentage  float64 `json:"unrefinedPercentage"`
 	HealthStatus         string  `json:"healthStatus"`
 }
 
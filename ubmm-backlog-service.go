@@ -10,7 +10,6 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
@@ -23,8 +22,11 @@ import (
 	"github.com/ubmm/backlog-service/internal/adapters/eventbus"
 	"github.com/ubmm/backlog-service/internal/adapters/cache"
 	"github.com/ubmm/backlog-service/internal/adapters/grpc"
+	"github.com/ubmm/backlog-service/internal/adapters/web"
 	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/event"
 	"github.com/ubmm/backlog-service/internal/domain/service"
+	"github.com/ubmm/backlog-service/internal/lifecycle"
 )
 
 func main() {
@@ -41,33 +43,63 @@ func main() {
 		logger.Fatal("Failed to load configuration", zap.Error(err))
 	}
 
-	// Initialize database
-	dbAdapter, err := db.NewPostgresAdapter(cfg.Database)
-	if err != nil {
-		logger.Fatal("Failed to initialize database", zap.Error(err))
-	}
-	defer dbAdapter.Close()
+	// Bring dependencies up in order, retrying each with backoff instead of
+	// failing hard on a briefly unavailable Postgres or Kafka. Kafka is
+	// optional under degraded start: the service then serves reads with
+	// publishing disabled.
+	initializer := lifecycle.NewInitializer(cfg.Startup.MaxWait, logger)
+
+	var dbAdapter *db.PostgresAdapter
+	initializer.Register("postgres", true, func(ctx context.Context) error {
+		adapter, err := db.NewPostgresAdapter(cfg.Database)
+		if err != nil {
+			return err
+		}
+		dbAdapter = adapter
+		return nil
+	})
 
-	// Initialize cache
-	cacheAdapter, err := cache.NewRedisAdapter(cfg.Cache)
-	if err != nil {
-		logger.Fatal("Failed to initialize cache", zap.Error(err))
+	var cacheAdapter *cache.RedisAdapter
+	initializer.Register("redis", true, func(ctx context.Context) error {
+		adapter, err := cache.NewRedisAdapter(cfg.Cache)
+		if err != nil {
+			return err
+		}
+		cacheAdapter = adapter
+		return nil
+	})
+
+	// Closed by the drainer so pending publishes are flushed before exit
+	var eventBusAdapter *eventbus.KafkaAdapter
+	initializer.Register("kafka", !cfg.Startup.DegradedStart, func(ctx context.Context) error {
+		adapter, err := eventbus.NewKafkaAdapter(cfg.EventBus)
+		if err != nil {
+			return err
+		}
+		eventBusAdapter = adapter
+		return nil
+	})
+
+	if err := initializer.Run(context.Background()); err != nil {
+		logger.Fatal("Failed to initialize dependencies", zap.Error(err))
 	}
+	defer dbAdapter.Close()
 	defer cacheAdapter.Close()
 
-	// Initialize event bus
-	eventBusAdapter, err := eventbus.NewKafkaAdapter(cfg.EventBus)
-	if err != nil {
-		logger.Fatal("Failed to initialize event bus", zap.Error(err))
+	// Initialize domain service; degraded starts publish nowhere until a
+	// restart brings Kafka back
+	var publisher event.Publisher = eventBusAdapter
+	if eventBusAdapter == nil {
+		publisher = event.NewNoopPublisher()
 	}
-	defer eventBusAdapter.Close()
-
-	// Initialize domain service
-	domainService := service.NewBacklogService(dbAdapter, cacheAdapter, eventBusAdapter)
+	domainService := service.NewBacklogService(dbAdapter, cacheAdapter, publisher)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(grpc.UnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			grpc.UnaryServerInterceptor(),
+			grpc.SlowRPCInterceptor(cfg.Observability.SlowRPCThreshold, logger),
+		),
 		grpc.StreamInterceptor(grpc.StreamServerInterceptor()),
 	)
 
@@ -80,6 +112,16 @@ func main() {
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 
+	// Drain coordinator: background subsystems register here so deploys can
+	// stop new work, flush what is in flight, and only then exit. Register
+	// dependencies before their consumers.
+	drainer := lifecycle.NewDrainer(healthServer, cfg.Server.GracefulShutdownTimeout, logger)
+	if eventBusAdapter != nil {
+		drainer.Register("event-bus", func(ctx context.Context) error {
+			return eventBusAdapter.Close()
+		})
+	}
+
 	// Enable reflection
 	reflection.Register(grpcServer)
 
@@ -103,6 +145,14 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+	httpMux.HandleFunc("/drain", drainer.Handler())
+	httpMux.HandleFunc("/startup", initializer.Handler())
+	httpMux.HandleFunc(web.EventCatalogPath, web.EventCatalogHandler(logger))
+	httpMux.HandleFunc(web.AsyncAPIPath, web.AsyncAPIHandler(logger))
+
+	// Embedded ops console; disabled unless an admin token is configured
+	adminUI := web.NewAdminUI(cfg.Security.AdminToken, initializer, dbAdapter, cacheAdapter, nil, nil, logger)
+	adminUI.Register(httpMux)
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.HTTPPort),
@@ -116,23 +166,29 @@ func main() {
 		}
 	}()
 
-	// Graceful shutdown
+	// Graceful shutdown: a signal or the drain endpoint starts the drain
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	select {
+	case <-quit:
+	case <-drainer.Done():
+	}
 
 	logger.Info("Shutting down servers...")
 
-	// Shutdown HTTP server
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.GracefulShutdownTimeout)
 	defer cancel()
+
+	// Stop routing new work and wind down background subsystems; no-op if
+	// the drain endpoint already ran
+	drainer.Drain(ctx)
+
+	// Finish in-flight gRPC requests, then stop the HTTP server
+	grpcServer.GracefulStop()
 	if err := httpServer.Shutdown(ctx); err != nil {
 		logger.Error("Failed to shutdown HTTP server", zap.Error(err))
 	}
 
-	// Shutdown gRPC server
-	grpcServer.GracefulStop()
-
 	logger.Info("Servers shutdown complete")
 }
 
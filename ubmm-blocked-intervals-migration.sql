@@ -0,0 +1,31 @@
+-- services/backlog-service/migrations/000008_blocked_tracking.up.sql
+
+-- Blocking an item now requires a reason and may reference the blocking item
+ALTER TABLE backlog_items ADD COLUMN blocked_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE backlog_items ADD COLUMN blocked_by_id UUID REFERENCES backlog_items(id) ON DELETE SET NULL;
+
+-- Blocked intervals are first-class data: one row per contiguous stretch an
+-- item spent blocked, so total blocked time survives later status churn
+CREATE TABLE IF NOT EXISTS blocked_intervals (
+    id UUID PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES backlog_items(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    blocked_by_id UUID,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_blocked_intervals_item_id ON blocked_intervals(item_id);
+CREATE INDEX idx_blocked_intervals_open ON blocked_intervals(started_at) WHERE ended_at IS NULL;
+
+-- Seed open intervals for items already blocked today
+INSERT INTO blocked_intervals (id, item_id, reason, started_at)
+SELECT gen_random_uuid(), id, 'Blocked before reason tracking', COALESCE(blocked_since, updated_at)
+FROM backlog_items
+WHERE status = 'BLOCKED';
+
+-- services/backlog-service/migrations/000008_blocked_tracking.down.sql
+
+DROP TABLE IF EXISTS blocked_intervals;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS blocked_by_id;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS blocked_reason;
@@ -0,0 +1,223 @@
+// services/backlog-service/internal/domain/service/blocked_tracking.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// BlockedInterval is one contiguous stretch an item spent blocked
+type BlockedInterval struct {
+	ID          uuid.UUID  `json:"id"`
+	ItemID      uuid.UUID  `json:"itemId"`
+	Reason      string     `json:"reason"`
+	BlockedByID *uuid.UUID `json:"blockedById,omitempty"`
+	StartedAt   time.Time  `json:"startedAt"`
+	EndedAt     *time.Time `json:"endedAt,omitempty"` // nil while the item is still blocked
+}
+
+// BlockedIntervalRepository persists blocked intervals
+type BlockedIntervalRepository interface {
+	// Open stores a new open interval
+	Open(ctx context.Context, interval *BlockedInterval) error
+
+	// Close closes the open interval for an item
+	Close(ctx context.Context, itemID uuid.UUID, endedAt time.Time) error
+
+	// GetByItemID retrieves all intervals for an item, oldest first
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*BlockedInterval, error)
+
+	// ListOpen lists every currently open interval
+	ListOpen(ctx context.Context) ([]*BlockedInterval, error)
+}
+
+// BlockedTrackingService records why and for how long items are blocked
+type BlockedTrackingService struct {
+	backlog   *BacklogService
+	intervals BlockedIntervalRepository
+	logger    *zap.Logger
+}
+
+// NewBlockedTrackingService creates a new blocked tracking service
+func NewBlockedTrackingService(backlog *BacklogService, intervals BlockedIntervalRepository, logger *zap.Logger) *BlockedTrackingService {
+	return &BlockedTrackingService{
+		backlog:   backlog,
+		intervals: intervals,
+		logger:    logger,
+	}
+}
+
+// BlockItem blocks an item with the required reason and an optional reference
+// to the blocking item, opening a blocked interval
+func (s *BlockedTrackingService) BlockItem(ctx context.Context, itemID uuid.UUID, reason string, blockedBy *uuid.UUID) (*model.BacklogItem, error) {
+	item, err := s.backlog.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Status == model.ItemStatusBlocked {
+		return nil, errors.New("item is already blocked")
+	}
+
+	// The blocking item must exist if referenced
+	if blockedBy != nil {
+		if _, err := s.backlog.repo.GetByID(ctx, *blockedBy); err != nil {
+			return nil, errors.New("blocking item not found")
+		}
+	}
+
+	if err := item.Block(reason, blockedBy); err != nil {
+		return nil, err
+	}
+
+	if err := s.backlog.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	// Record the interval
+	interval := &BlockedInterval{
+		ID:          uuid.New(),
+		ItemID:      itemID,
+		Reason:      reason,
+		BlockedByID: blockedBy,
+		StartedAt:   *item.BlockedSince,
+	}
+	if err := s.intervals.Open(ctx, interval); err != nil {
+		s.logger.Error("Failed to record blocked interval", zap.Error(err))
+	}
+
+	// Store and publish event
+	blockedEvent := event.NewItemBlockedEvent(itemID, reason, blockedBy)
+	if err := s.backlog.eventRepo.StoreEvent(ctx, blockedEvent); err != nil {
+		s.logger.Error("Failed to store item blocked event", zap.Error(err))
+	}
+	if err := s.backlog.eventPublisher.Publish(ctx, "backlog.item.blocked", blockedEvent); err != nil {
+		s.logger.Error("Failed to publish item blocked event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.backlog.cache.Delete(ctx, "item:"+itemID.String())
+	s.backlog.invalidateListCache(ctx)
+
+	return item, nil
+}
+
+// UnblockItem moves a blocked item to the given status and closes its open
+// blocked interval
+func (s *BlockedTrackingService) UnblockItem(ctx context.Context, itemID uuid.UUID, newStatus model.ItemStatus) (*model.BacklogItem, error) {
+	item, err := s.backlog.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Status != model.ItemStatusBlocked {
+		return nil, errors.New("item is not blocked")
+	}
+
+	blockedSince := item.BlockedSince
+	if err := item.UpdateStatus(newStatus); err != nil {
+		return nil, err
+	}
+
+	if err := s.backlog.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	// Close the interval
+	now := time.Now().UTC()
+	if err := s.intervals.Close(ctx, itemID, now); err != nil {
+		s.logger.Error("Failed to close blocked interval", zap.Error(err))
+	}
+
+	blockedHours := 0.0
+	if blockedSince != nil {
+		blockedHours = now.Sub(*blockedSince).Hours()
+	}
+
+	// Store and publish event
+	unblockedEvent := event.NewItemUnblockedEvent(itemID, blockedHours)
+	if err := s.backlog.eventRepo.StoreEvent(ctx, unblockedEvent); err != nil {
+		s.logger.Error("Failed to store item unblocked event", zap.Error(err))
+	}
+	if err := s.backlog.eventPublisher.Publish(ctx, "backlog.item.unblocked", unblockedEvent); err != nil {
+		s.logger.Error("Failed to publish item unblocked event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.backlog.cache.Delete(ctx, "item:"+itemID.String())
+	s.backlog.invalidateListCache(ctx)
+
+	return item, nil
+}
+
+// GetTotalBlockedTime sums every blocked interval for an item; an open
+// interval counts up to now
+func (s *BlockedTrackingService) GetTotalBlockedTime(ctx context.Context, itemID uuid.UUID) (time.Duration, error) {
+	intervals, err := s.intervals.GetByItemID(ctx, itemID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	var total time.Duration
+	for _, interval := range intervals {
+		end := now
+		if interval.EndedAt != nil {
+			end = *interval.EndedAt
+		}
+		total += end.Sub(interval.StartedAt)
+	}
+
+	return total, nil
+}
+
+// BlockedItemReport is one currently blocked item with its reason
+type BlockedItemReport struct {
+	Item         *model.BacklogItem `json:"item"`
+	Reason       string             `json:"reason"`
+	BlockedByID  *uuid.UUID         `json:"blockedById,omitempty"`
+	BlockedSince time.Time          `json:"blockedSince"`
+	BlockedFor   time.Duration      `json:"blockedFor"`
+}
+
+// GetBlockedReport lists every currently blocked item with its reason,
+// longest-blocked first
+func (s *BlockedTrackingService) GetBlockedReport(ctx context.Context) ([]*BlockedItemReport, error) {
+	open, err := s.intervals.ListOpen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	report := make([]*BlockedItemReport, 0, len(open))
+	for _, interval := range open {
+		item, err := s.backlog.GetItem(ctx, interval.ItemID)
+		if err != nil {
+			s.logger.Error("Failed to load blocked item", zap.String("itemID", interval.ItemID.String()), zap.Error(err))
+			continue
+		}
+		report = append(report, &BlockedItemReport{
+			Item:         item,
+			Reason:       interval.Reason,
+			BlockedByID:  interval.BlockedByID,
+			BlockedSince: interval.StartedAt,
+			BlockedFor:   now.Sub(interval.StartedAt),
+		})
+	}
+
+	// Longest-blocked first, so the worst stalls lead the report
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].BlockedFor > report[j].BlockedFor
+	})
+
+	return report, nil
+}
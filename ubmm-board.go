@@ -0,0 +1,238 @@
+// services/backlog-service/internal/domain/service/board.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// SwimlaneMode defines how board rows are grouped
+type SwimlaneMode string
+
+const (
+	// SwimlaneNone renders a single lane
+	SwimlaneNone SwimlaneMode = "NONE"
+	// SwimlaneByAssignee groups rows by assignee
+	SwimlaneByAssignee SwimlaneMode = "ASSIGNEE"
+	// SwimlaneByEpic groups rows by ancestor epic
+	SwimlaneByEpic SwimlaneMode = "EPIC"
+	// SwimlaneByType groups rows by item type
+	SwimlaneByType SwimlaneMode = "TYPE"
+)
+
+// BoardColumn maps one board column to one or more statuses
+type BoardColumn struct {
+	Name     string             `json:"name"`
+	Statuses []model.ItemStatus `json:"statuses"`
+
+	// WIPLimit caps the items in the column; 0 means unlimited
+	WIPLimit int `json:"wipLimit"`
+}
+
+// BoardConfig describes the column layout and swimlane grouping of a board
+type BoardConfig struct {
+	Columns  []BoardColumn `json:"columns"`
+	Swimlane SwimlaneMode  `json:"swimlane"`
+}
+
+// DefaultBoardConfig is the board layout used when none is configured
+var DefaultBoardConfig = BoardConfig{
+	Columns: []BoardColumn{
+		{Name: "To Do", Statuses: []model.ItemStatus{model.ItemStatusNew, model.ItemStatusReady}},
+		{Name: "In Progress", Statuses: []model.ItemStatus{model.ItemStatusInProgress}, WIPLimit: 10},
+		{Name: "Blocked", Statuses: []model.ItemStatus{model.ItemStatusBlocked}},
+		{Name: "Done", Statuses: []model.ItemStatus{model.ItemStatusDone}},
+	},
+	Swimlane: SwimlaneNone,
+}
+
+// BoardCell holds the items of one column within one swimlane, ordered by rank
+type BoardCell struct {
+	Items []*model.BacklogItem `json:"items"`
+
+	// OverWIPLimit flags the column when it exceeds its configured WIP limit
+	OverWIPLimit bool `json:"overWipLimit"`
+}
+
+// BoardView is the fully organized board returned by GetBoard, ready for
+// direct rendering: swimlane name → column name → cell
+type BoardView struct {
+	Config    BoardConfig                      `json:"config"`
+	Swimlanes map[string]map[string]*BoardCell `json:"swimlanes"`
+}
+
+// GetBoard returns the backlog organized into the configured columns and
+// swimlanes
+func (s *BacklogService) GetBoard(ctx context.Context, config BoardConfig) (*BoardView, error) {
+	if len(config.Columns) == 0 {
+		config = DefaultBoardConfig
+	}
+
+	// Collect the statuses visible on the board
+	var statuses []model.ItemStatus
+	for _, column := range config.Columns {
+		statuses = append(statuses, column.Statuses...)
+	}
+
+	items, _, err := s.repo.List(ctx, repository.BacklogFilter{
+		Statuses:  statuses,
+		Limit:     1000,
+		SortBy:    "priority",
+		SortOrder: "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	view := &BoardView{
+		Config:    config,
+		Swimlanes: make(map[string]map[string]*BoardCell),
+	}
+
+	// Column totals for WIP limit flagging (limits apply across swimlanes)
+	columnCounts := make(map[string]int)
+
+	for _, item := range items {
+		column := columnFor(config, item.Status)
+		if column == "" {
+			continue
+		}
+		lane, err := s.swimlaneFor(ctx, config.Swimlane, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if view.Swimlanes[lane] == nil {
+			view.Swimlanes[lane] = make(map[string]*BoardCell)
+		}
+		cell := view.Swimlanes[lane][column]
+		if cell == nil {
+			cell = &BoardCell{}
+			view.Swimlanes[lane][column] = cell
+		}
+		cell.Items = append(cell.Items, item)
+		columnCounts[column]++
+	}
+
+	// Flag columns over their WIP limit
+	for _, column := range config.Columns {
+		if column.WIPLimit > 0 && columnCounts[column.Name] > column.WIPLimit {
+			for _, columns := range view.Swimlanes {
+				if cell, ok := columns[column.Name]; ok {
+					cell.OverWIPLimit = true
+				}
+			}
+		}
+	}
+
+	return view, nil
+}
+
+// MoveCardRequest moves an item to a new column position in one call
+type MoveCardRequest struct {
+	ItemID      uuid.UUID
+	Column      string
+	NewPriority int
+}
+
+// MoveCard applies a board card move: the status change implied by the target
+// column and the rank change, atomically from the caller's perspective
+func (s *BacklogService) MoveCard(ctx context.Context, config BoardConfig, req *MoveCardRequest) (*model.BacklogItem, error) {
+	if len(config.Columns) == 0 {
+		config = DefaultBoardConfig
+	}
+
+	// Resolve the target column's primary status
+	var target *BoardColumn
+	for idx := range config.Columns {
+		if config.Columns[idx].Name == req.Column {
+			target = &config.Columns[idx]
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("unknown board column %q", req.Column)
+	}
+	if len(target.Statuses) == 0 {
+		return nil, errors.New("board column has no statuses")
+	}
+	status := target.Statuses[0]
+
+	// Enforce the WIP limit before moving in
+	if target.WIPLimit > 0 {
+		_, count, err := s.repo.List(ctx, repository.BacklogFilter{
+			Statuses: target.Statuses,
+			Limit:    1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if int(count) >= target.WIPLimit {
+			return nil, fmt.Errorf("column %q is at its WIP limit (%d)", target.Name, target.WIPLimit)
+		}
+	}
+
+	return s.UpdateItem(ctx, req.ItemID, &UpdateItemRequest{
+		Status:   &status,
+		Priority: &req.NewPriority,
+	})
+}
+
+// columnFor finds the column displaying a status
+func columnFor(config BoardConfig, status model.ItemStatus) string {
+	for _, column := range config.Columns {
+		for _, s := range column.Statuses {
+			if s == status {
+				return column.Name
+			}
+		}
+	}
+	return ""
+}
+
+// swimlaneFor computes the swimlane key for an item
+func (s *BacklogService) swimlaneFor(ctx context.Context, mode SwimlaneMode, item *model.BacklogItem) (string, error) {
+	switch mode {
+	case SwimlaneByAssignee:
+		if item.Assignee == "" {
+			return "Unassigned", nil
+		}
+		return item.Assignee, nil
+	case SwimlaneByType:
+		return string(item.Type), nil
+	case SwimlaneByEpic:
+		epic, err := s.ancestorEpic(ctx, item)
+		if err != nil {
+			return "", err
+		}
+		if epic == nil {
+			return "No Epic", nil
+		}
+		return epic.Title, nil
+	default:
+		return "default", nil
+	}
+}
+
+// ancestorEpic walks up the parent chain to the owning epic
+func (s *BacklogService) ancestorEpic(ctx context.Context, item *model.BacklogItem) (*model.BacklogItem, error) {
+	current := item
+	for current.ParentID != nil {
+		parent, err := s.repo.GetByID(ctx, *current.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.Type == model.ItemTypeEpic {
+			return parent, nil
+		}
+		current = parent
+	}
+	return nil, nil
+}
@@ -0,0 +1,172 @@
+// services/backlog-service/internal/domain/service/budget.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// EpicBudgetReport compares an epic's budget with its actual cost to date
+type EpicBudgetReport struct {
+	ItemID     uuid.UUID `json:"itemId"`
+	Title      string    `json:"title"`
+	Budget     float64   `json:"budget"`
+	ActualCost float64   `json:"actualCost"`
+	Variance   float64   `json:"variance"` // budget - actual; negative means overspend
+	Overspent  bool      `json:"overspent"`
+}
+
+// PortfolioBudgetReport rolls budget vs actual up across all epics
+type PortfolioBudgetReport struct {
+	Epics           []*EpicBudgetReport `json:"epics"`
+	TotalBudget     float64             `json:"totalBudget"`
+	TotalActualCost float64             `json:"totalActualCost"`
+	TotalVariance   float64             `json:"totalVariance"`
+}
+
+// BudgetService computes actual delivery cost against epic budgets. Actual
+// cost comes from logged work when available, otherwise from completed story
+// points times the blended rate.
+type BudgetService struct {
+	backlog        *BacklogService
+	worklogs       WorkLogRepository
+	hourlyRate     float64 // blended cost per logged hour
+	ratePerPoint   float64 // fallback blended cost per story point
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewBudgetService creates a new budget service
+func NewBudgetService(backlog *BacklogService, worklogs WorkLogRepository, hourlyRate, ratePerPoint float64, eventPublisher event.Publisher, logger *zap.Logger) *BudgetService {
+	return &BudgetService{
+		backlog:        backlog,
+		worklogs:       worklogs,
+		hourlyRate:     hourlyRate,
+		ratePerPoint:   ratePerPoint,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// SetBudget sets an epic's delivery budget
+func (s *BudgetService) SetBudget(ctx context.Context, epicID uuid.UUID, budget float64) (*model.BacklogItem, error) {
+	item, err := s.backlog.GetItem(ctx, epicID)
+	if err != nil {
+		return nil, err
+	}
+	if err := item.UpdateBudget(budget); err != nil {
+		return nil, err
+	}
+	if err := s.backlog.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+	s.backlog.cache.Delete(ctx, "item:"+epicID.String())
+	return item, nil
+}
+
+// GetEpicBudgetReport computes budget vs actual for one epic, walking its
+// features and stories. It publishes an overspend warning when actual cost
+// exceeds the budget.
+func (s *BudgetService) GetEpicBudgetReport(ctx context.Context, epicID uuid.UUID) (*EpicBudgetReport, error) {
+	epic, err := s.backlog.GetItem(ctx, epicID)
+	if err != nil {
+		return nil, err
+	}
+	if epic.Type != model.ItemTypeEpic {
+		return nil, fmt.Errorf("item %s is not an epic", epicID)
+	}
+
+	actual, err := s.actualCostOfTree(ctx, epic)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &EpicBudgetReport{
+		ItemID:     epic.ID,
+		Title:      epic.Title,
+		Budget:     epic.Budget,
+		ActualCost: actual,
+		Variance:   epic.Budget - actual,
+		Overspent:  epic.Budget > 0 && actual > epic.Budget,
+	}
+
+	if report.Overspent {
+		overspendEvent := event.NewBudgetOverspendEvent(epic.ID, epic.Budget, actual)
+		if err := s.eventPublisher.Publish(ctx, "backlog.item.budget.overspend", overspendEvent); err != nil {
+			s.logger.Error("Failed to publish budget overspend event", zap.Error(err))
+		}
+	}
+
+	return report, nil
+}
+
+// GetPortfolioBudgetReport rolls budget vs actual up across every epic
+func (s *BudgetService) GetPortfolioBudgetReport(ctx context.Context) (*PortfolioBudgetReport, error) {
+	epics, _, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+		Types: []model.ItemType{model.ItemTypeEpic},
+		Limit: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &PortfolioBudgetReport{}
+	for _, epic := range epics {
+		report, err := s.GetEpicBudgetReport(ctx, epic.ID)
+		if err != nil {
+			return nil, err
+		}
+		portfolio.Epics = append(portfolio.Epics, report)
+		portfolio.TotalBudget += report.Budget
+		portfolio.TotalActualCost += report.ActualCost
+	}
+	portfolio.TotalVariance = portfolio.TotalBudget - portfolio.TotalActualCost
+
+	return portfolio, nil
+}
+
+// actualCostOfTree sums the actual cost of an item and all its descendants
+func (s *BudgetService) actualCostOfTree(ctx context.Context, item *model.BacklogItem) (float64, error) {
+	cost, err := s.actualCostOfItem(ctx, item)
+	if err != nil {
+		return 0, err
+	}
+
+	children, err := s.backlog.repo.GetChildren(ctx, item.ID)
+	if err != nil {
+		return 0, err
+	}
+	for _, child := range children {
+		childCost, err := s.actualCostOfTree(ctx, child)
+		if err != nil {
+			return 0, err
+		}
+		cost += childCost
+	}
+
+	return cost, nil
+}
+
+// actualCostOfItem costs one item: logged work at the hourly rate when any
+// exists, otherwise completed story points at the blended per-point rate
+func (s *BudgetService) actualCostOfItem(ctx context.Context, item *model.BacklogItem) (float64, error) {
+	logged, err := s.worklogs.TotalByItemID(ctx, item.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load logged work: %w", err)
+	}
+	if logged > 0 {
+		return logged.Hours() * s.hourlyRate, nil
+	}
+	if item.Status == model.ItemStatusDone {
+		return float64(item.StoryPoints) * s.ratePerPoint, nil
+	}
+	return 0, nil
+}
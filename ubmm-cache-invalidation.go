@@ -0,0 +1,121 @@
+// services/backlog-service/internal/adapters/eventbus/cache_invalidation.go
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/config"
+)
+
+// CacheEvictor is the slice of the cache interface the invalidation
+// consumer needs
+type CacheEvictor interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// invalidationTopics are the item lifecycle topics whose events make warmed
+// cache entries stale
+var invalidationTopics = []string{
+	"backlog.item.created",
+	"backlog.item.updated",
+	"backlog.item.deleted",
+	"backlog.items.reordered",
+	"backlog.item.merged",
+	"backlog.item.split",
+}
+
+// CacheInvalidationConsumer evicts stale cache entries on every replica when
+// any replica writes. Each instance joins its own consumer group so all of
+// them see every backlog.item.* event.
+type CacheInvalidationConsumer struct {
+	consumer *KafkaConsumer
+	cache    CacheEvictor
+	logger   *zap.Logger
+}
+
+// NewCacheInvalidationConsumer creates a new cache invalidation consumer.
+// The consumer group is derived from the hostname so every replica receives
+// the full event stream.
+func NewCacheInvalidationConsumer(cfg config.KafkaConfig, cache CacheEvictor, logger *zap.Logger) (*CacheInvalidationConsumer, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	group := fmt.Sprintf("backlog-cache-invalidation-%s", hostname)
+
+	consumer, err := NewKafkaConsumer(cfg, group, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invalidation consumer: %w", err)
+	}
+	if err := consumer.Subscribe(invalidationTopics); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("failed to subscribe to invalidation topics: %w", err)
+	}
+
+	return &CacheInvalidationConsumer{
+		consumer: consumer,
+		cache:    cache,
+		logger:   logger,
+	}, nil
+}
+
+// invalidationPayload is the slice of the event payload the consumer reads
+type invalidationPayload struct {
+	ItemID         string            `json:"itemId"`
+	ItemPriorities map[string]int    `json:"itemPriorities"`
+	Item           *struct {
+		ParentID *string `json:"parentId"`
+		Key      string  `json:"key"`
+	} `json:"item"`
+}
+
+// Start consumes invalidation events until the context is cancelled
+func (c *CacheInvalidationConsumer) Start(ctx context.Context) error {
+	return c.consumer.ConsumeMessages(ctx, func(message *kafka.Message) error {
+		var payload invalidationPayload
+		if err := json.Unmarshal(message.Value, &payload); err != nil {
+			// Unparseable events can't name their keys; skip rather than block
+			c.logger.Error("Failed to parse invalidation event", zap.Error(err))
+			return nil
+		}
+
+		c.evict(ctx, &payload)
+
+		// List and metrics caches depend on ordering and membership, so any
+		// item event invalidates them
+		c.cache.Delete(ctx, "list:all")
+		c.cache.Delete(ctx, "metrics")
+
+		return nil
+	})
+}
+
+// evict removes the per-item keys affected by one event
+func (c *CacheInvalidationConsumer) evict(ctx context.Context, payload *invalidationPayload) {
+	if payload.ItemID != "" {
+		c.cache.Delete(ctx, "item:"+payload.ItemID)
+	}
+	for itemID := range payload.ItemPriorities {
+		c.cache.Delete(ctx, "item:"+itemID)
+	}
+	if payload.Item != nil {
+		if payload.Item.Key != "" {
+			c.cache.Delete(ctx, "itemkey:"+payload.Item.Key)
+		}
+		if payload.Item.ParentID != nil {
+			c.cache.Delete(ctx, "children:"+*payload.Item.ParentID)
+		}
+	}
+}
+
+// Close shuts the consumer down
+func (c *CacheInvalidationConsumer) Close() error {
+	return c.consumer.Close()
+}
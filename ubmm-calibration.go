@@ -0,0 +1,87 @@
+// services/backlog-service/internal/domain/service/calibration.go
+
+package service
+
+import (
+	"context"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ConfidenceCalibration summarizes how one confidence level's estimates
+// compare with reality
+type ConfidenceCalibration struct {
+	Confidence        model.EstimateConfidence `json:"confidence"`
+	ItemsCompleted    int                      `json:"itemsCompleted"`
+	AvgStoryPoints    float64                  `json:"avgStoryPoints"`
+	AvgCycleTimeDays  float64                  `json:"avgCycleTimeDays"`
+	DaysPerPoint      float64                  `json:"daysPerPoint"`
+}
+
+// CalibrationReport correlates estimate confidence with actual cycle time so
+// teams can see whether "high confidence" estimates really are more accurate
+type CalibrationReport struct {
+	Levels []*ConfidenceCalibration `json:"levels"`
+}
+
+// GetCalibrationReport builds the estimate calibration report from completed
+// items. Cycle time is approximated as the time between creation and the
+// final update, matching the lead-time definition used by the metrics views.
+func (s *BacklogService) GetCalibrationReport(ctx context.Context) (*CalibrationReport, error) {
+	type bucket struct {
+		items     int
+		points    int
+		cycleDays float64
+	}
+	buckets := map[model.EstimateConfidence]*bucket{
+		model.ConfidenceLow:    {},
+		model.ConfidenceMedium: {},
+		model.ConfidenceHigh:   {},
+	}
+
+	offset := 0
+	for {
+		items, total, err := s.repo.List(ctx, repository.BacklogFilter{
+			Statuses: []model.ItemStatus{model.ItemStatusDone},
+			Limit:    200,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			b, ok := buckets[item.Confidence]
+			if !ok {
+				// Items estimated before confidence tracking existed
+				continue
+			}
+			b.items++
+			b.points += item.StoryPoints
+			b.cycleDays += item.UpdatedAt.Sub(item.CreatedAt).Hours() / 24
+		}
+		offset += len(items)
+		if int64(offset) >= total || len(items) == 0 {
+			break
+		}
+	}
+
+	report := &CalibrationReport{}
+	for _, confidence := range []model.EstimateConfidence{model.ConfidenceLow, model.ConfidenceMedium, model.ConfidenceHigh} {
+		b := buckets[confidence]
+		level := &ConfidenceCalibration{
+			Confidence:     confidence,
+			ItemsCompleted: b.items,
+		}
+		if b.items > 0 {
+			level.AvgStoryPoints = float64(b.points) / float64(b.items)
+			level.AvgCycleTimeDays = b.cycleDays / float64(b.items)
+		}
+		if b.points > 0 {
+			level.DaysPerPoint = b.cycleDays / float64(b.points)
+		}
+		report.Levels = append(report.Levels, level)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,107 @@
+// services/backlog-service/internal/domain/service/capacity_report.go
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// CapacityTrend classifies the demand-vs-completion balance
+type CapacityTrend string
+
+const (
+	// CapacityTrendGrowing means demand outpaces completion: the backlog is unbounded
+	CapacityTrendGrowing CapacityTrend = "GROWING"
+	// CapacityTrendShrinking means completion outpaces demand
+	CapacityTrendShrinking CapacityTrend = "SHRINKING"
+	// CapacityTrendBalanced means demand and completion are roughly equal
+	CapacityTrendBalanced CapacityTrend = "BALANCED"
+)
+
+// TypeCapacity is the demand/completion breakdown for one item type
+type TypeCapacity struct {
+	Demand     repository.WeeklyRate `json:"demand"`
+	Completion repository.WeeklyRate `json:"completion"`
+	NetPerWeek float64               `json:"netPerWeek"` // items added minus completed
+}
+
+// CapacityReport compares incoming demand with completion rate and projects
+// where the backlog is heading — the core unbounded-backlog early warning
+type CapacityReport struct {
+	WindowWeeks     int                              `json:"windowWeeks"`
+	TotalBacklog    int                              `json:"totalBacklog"`
+	DemandPerWeek   repository.WeeklyRate            `json:"demandPerWeek"`
+	CompletionPerWeek repository.WeeklyRate          `json:"completionPerWeek"`
+	Trend           CapacityTrend                    `json:"trend"`
+	ByType          map[model.ItemType]*TypeCapacity `json:"byType"`
+
+	// ProjectedBurnDownDate is set when the backlog is shrinking: the date at
+	// which the current backlog would empty at the current net rate
+	ProjectedBurnDownDate *time.Time `json:"projectedBurnDownDate,omitempty"`
+
+	// ProjectedGrowthPerQuarter is set when the backlog is growing: how many
+	// items it gains per quarter at the current net rate
+	ProjectedGrowthPerQuarter float64 `json:"projectedGrowthPerQuarter,omitempty"`
+}
+
+// GetCapacityReport builds the capacity vs demand report over the given
+// window (in weeks, default 12)
+func (s *BacklogService) GetCapacityReport(ctx context.Context, windowWeeks int) (*CapacityReport, error) {
+	if windowWeeks <= 0 {
+		windowWeeks = 12
+	}
+
+	demand, err := s.metricsRepo.GetWeeklyDemand(ctx, windowWeeks)
+	if err != nil {
+		return nil, err
+	}
+	completion, err := s.metricsRepo.GetWeeklyCompletion(ctx, windowWeeks)
+	if err != nil {
+		return nil, err
+	}
+	sizeCounts, err := s.metricsRepo.GetBacklogSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CapacityReport{
+		WindowWeeks: windowWeeks,
+		ByType:      make(map[model.ItemType]*TypeCapacity),
+	}
+
+	for _, itemType := range []model.ItemType{model.ItemTypeEpic, model.ItemTypeFeature, model.ItemTypeStory} {
+		d := demand[itemType]
+		c := completion[itemType]
+		report.ByType[itemType] = &TypeCapacity{
+			Demand:     d,
+			Completion: c,
+			NetPerWeek: d.ItemsPerWeek - c.ItemsPerWeek,
+		}
+		report.DemandPerWeek.ItemsPerWeek += d.ItemsPerWeek
+		report.DemandPerWeek.PointsPerWeek += d.PointsPerWeek
+		report.CompletionPerWeek.ItemsPerWeek += c.ItemsPerWeek
+		report.CompletionPerWeek.PointsPerWeek += c.PointsPerWeek
+		report.TotalBacklog += sizeCounts[itemType]
+	}
+
+	// Classify the trend and project the consequence
+	net := report.DemandPerWeek.ItemsPerWeek - report.CompletionPerWeek.ItemsPerWeek
+	switch {
+	case net > 0.5:
+		report.Trend = CapacityTrendGrowing
+		report.ProjectedGrowthPerQuarter = net * 13
+	case net < -0.5:
+		report.Trend = CapacityTrendShrinking
+		weeksToEmpty := float64(report.TotalBacklog) / -net
+		burnDown := time.Now().UTC().Add(time.Duration(weeksToEmpty*7*24) * time.Hour)
+		report.ProjectedBurnDownDate = &burnDown
+	default:
+		report.Trend = CapacityTrendBalanced
+	}
+
+	return report, nil
+}
@@ -0,0 +1,223 @@
+// services/backlog-service/internal/domain/service/cascade.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// CascadeMode selects what happens to an item's children when the item is
+// deleted or closed
+type CascadeMode string
+
+const (
+	// CascadeBlock refuses the operation while children exist and reports them
+	CascadeBlock CascadeMode = "BLOCK"
+	// CascadeApply applies the operation to the whole subtree
+	CascadeApply CascadeMode = "APPLY"
+	// CascadeReparent moves children up to the item's own parent first
+	CascadeReparent CascadeMode = "REPARENT"
+)
+
+// CascadeBlockedError reports the children that stopped a blocked cascade,
+// so clients can show exactly what is in the way
+type CascadeBlockedError struct {
+	Children []*model.BacklogItem
+}
+
+// Error implements the error interface
+func (e *CascadeBlockedError) Error() string {
+	return fmt.Sprintf("operation blocked: item has %d children", len(e.Children))
+}
+
+// DeleteItemCascade deletes an item with the chosen handling of its
+// children. Descendants are processed leaves-first so the tree never holds a
+// dangling parent reference, and the whole cascade is recorded as one event.
+func (s *BacklogService) DeleteItemCascade(ctx context.Context, id uuid.UUID, mode CascadeMode) error {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	children, err := s.repo.GetChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var affected []uuid.UUID
+	switch mode {
+	case CascadeBlock, "":
+		if len(children) > 0 {
+			return &CascadeBlockedError{Children: children}
+		}
+
+	case CascadeReparent:
+		for _, child := range children {
+			if err := child.UpdateParent(item.ParentID); err != nil {
+				return err
+			}
+			if err := s.repo.Update(ctx, child); err != nil {
+				return err
+			}
+			s.cache.Delete(ctx, "item:"+child.ID.String())
+			affected = append(affected, child.ID)
+		}
+
+	case CascadeApply:
+		subtree, err := s.collectSubtree(ctx, id)
+		if err != nil {
+			return err
+		}
+		// Leaves first, so every delete removes a childless item
+		for i := len(subtree) - 1; i >= 0; i-- {
+			if err := s.repo.Delete(ctx, subtree[i]); err != nil {
+				return err
+			}
+			s.cache.Delete(ctx, "item:"+subtree[i].String())
+			affected = append(affected, subtree[i])
+		}
+
+	default:
+		return errors.New("invalid cascade mode")
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// One event covers the whole cascade
+	cascadeEvent := event.NewItemCascadeAppliedEvent(id, "DELETE", string(mode), affected)
+	if err := s.eventRepo.StoreEvent(ctx, cascadeEvent); err != nil {
+		s.logger.Error("Failed to store cascade event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.cascade", cascadeEvent); err != nil {
+		s.logger.Error("Failed to publish cascade event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.cache.Delete(ctx, "children:"+id.String())
+	s.invalidateListCache(ctx)
+
+	return nil
+}
+
+// CloseItemCascade moves an item to DONE with the chosen handling of its
+// open children: block and report them, close them too, or re-parent them to
+// the item's own parent so they stay open
+func (s *BacklogService) CloseItemCascade(ctx context.Context, id uuid.UUID, mode CascadeMode) error {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	children, err := s.repo.GetChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	var open []*model.BacklogItem
+	for _, child := range children {
+		if child.Status != model.ItemStatusDone {
+			open = append(open, child)
+		}
+	}
+
+	var affected []uuid.UUID
+	switch mode {
+	case CascadeBlock, "":
+		if len(open) > 0 {
+			return &CascadeBlockedError{Children: open}
+		}
+
+	case CascadeReparent:
+		for _, child := range open {
+			if err := child.UpdateParent(item.ParentID); err != nil {
+				return err
+			}
+			if err := s.repo.Update(ctx, child); err != nil {
+				return err
+			}
+			s.cache.Delete(ctx, "item:"+child.ID.String())
+			affected = append(affected, child.ID)
+		}
+
+	case CascadeApply:
+		subtree, err := s.collectSubtree(ctx, id)
+		if err != nil {
+			return err
+		}
+		// Leaves first, so parent auto-derivation never sees a done parent
+		// with open children
+		for i := len(subtree) - 1; i >= 0; i-- {
+			descendant, err := s.repo.GetByID(ctx, subtree[i])
+			if err != nil {
+				return err
+			}
+			if descendant.Status == model.ItemStatusDone {
+				continue
+			}
+			if err := descendant.UpdateStatus(model.ItemStatusDone); err != nil {
+				return fmt.Errorf("cannot close %s: %w", descendant.ID, err)
+			}
+			if err := s.repo.Update(ctx, descendant); err != nil {
+				return err
+			}
+			s.cache.Delete(ctx, "item:"+descendant.ID.String())
+			affected = append(affected, descendant.ID)
+		}
+
+	default:
+		return errors.New("invalid cascade mode")
+	}
+
+	if err := item.UpdateStatus(model.ItemStatusDone); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, item); err != nil {
+		return err
+	}
+
+	// One event covers the whole cascade
+	cascadeEvent := event.NewItemCascadeAppliedEvent(id, "CLOSE", string(mode), affected)
+	if err := s.eventRepo.StoreEvent(ctx, cascadeEvent); err != nil {
+		s.logger.Error("Failed to store cascade event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.cascade", cascadeEvent); err != nil {
+		s.logger.Error("Failed to publish cascade event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.invalidateListCache(ctx)
+
+	return nil
+}
+
+// collectSubtree returns the descendants of an item in depth-first order,
+// parents before their children
+func (s *BacklogService) collectSubtree(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	var subtree []uuid.UUID
+	queue := []uuid.UUID{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		children, err := s.repo.GetChildren(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			subtree = append(subtree, child.ID)
+			queue = append(queue, child.ID)
+		}
+	}
+	return subtree, nil
+}
@@ -0,0 +1,158 @@
+// services/backlog-service/internal/domain/service/checklist.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ChecklistEntry is one line in an item's checklist
+type ChecklistEntry struct {
+	ID        uuid.UUID `json:"id"`
+	ItemID    uuid.UUID `json:"itemId"`
+	Text      string    `json:"text"`
+	Done      bool      `json:"done"`
+	Assignee  string    `json:"assignee"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ChecklistRepository defines the interface for checklist persistence
+type ChecklistRepository interface {
+	// Create stores a new checklist entry
+	Create(ctx context.Context, entry *ChecklistEntry) error
+
+	// GetByID retrieves a checklist entry by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ChecklistEntry, error)
+
+	// GetByItemID retrieves all entries for an item ordered by position
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*ChecklistEntry, error)
+
+	// Update updates an existing entry
+	Update(ctx context.Context, entry *ChecklistEntry) error
+
+	// Delete removes an entry
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ChecklistSummary is the completion block surfaced on the parent item
+type ChecklistSummary struct {
+	Total              int     `json:"total"`
+	Done               int     `json:"done"`
+	CompletionPercent  float64 `json:"completionPercent"`
+}
+
+// ChecklistService manages generic checklists attachable to any backlog item
+type ChecklistService struct {
+	checklists ChecklistRepository
+	repo       repository.BacklogRepository
+}
+
+// NewChecklistService creates a new checklist service
+func NewChecklistService(checklists ChecklistRepository, repo repository.BacklogRepository) *ChecklistService {
+	return &ChecklistService{
+		checklists: checklists,
+		repo:       repo,
+	}
+}
+
+// AddEntry appends a new entry to an item's checklist
+func (s *ChecklistService) AddEntry(ctx context.Context, itemID uuid.UUID, text, assignee string) (*ChecklistEntry, error) {
+	if text == "" {
+		return nil, errors.New("checklist text cannot be empty")
+	}
+
+	// Make sure the item exists
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.checklists.GetByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	entry := &ChecklistEntry{
+		ID:        uuid.New(),
+		ItemID:    itemID,
+		Text:      text,
+		Assignee:  assignee,
+		Position:  len(existing),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.checklists.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry updates an entry's text, done flag, or assignee
+func (s *ChecklistService) UpdateEntry(ctx context.Context, id uuid.UUID, text *string, done *bool, assignee *string) (*ChecklistEntry, error) {
+	entry, err := s.checklists.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if text != nil {
+		if *text == "" {
+			return nil, errors.New("checklist text cannot be empty")
+		}
+		entry.Text = *text
+	}
+	if done != nil {
+		entry.Done = *done
+	}
+	if assignee != nil {
+		entry.Assignee = *assignee
+	}
+	entry.UpdatedAt = time.Now().UTC()
+
+	if err := s.checklists.Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// RemoveEntry deletes a checklist entry
+func (s *ChecklistService) RemoveEntry(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.checklists.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.checklists.Delete(ctx, id)
+}
+
+// GetChecklist retrieves an item's checklist entries
+func (s *ChecklistService) GetChecklist(ctx context.Context, itemID uuid.UUID) ([]*ChecklistEntry, error) {
+	return s.checklists.GetByItemID(ctx, itemID)
+}
+
+// GetSummary returns the completion percentage surfaced on the parent item
+func (s *ChecklistService) GetSummary(ctx context.Context, itemID uuid.UUID) (*ChecklistSummary, error) {
+	entries, err := s.checklists.GetByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ChecklistSummary{Total: len(entries)}
+	for _, entry := range entries {
+		if entry.Done {
+			summary.Done++
+		}
+	}
+	if summary.Total > 0 {
+		summary.CompletionPercent = float64(summary.Done) / float64(summary.Total) * 100
+	}
+
+	return summary, nil
+}
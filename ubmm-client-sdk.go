@@ -0,0 +1,171 @@
+// services/backlog-service/pkg/client/client.go
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// Config tunes the client's latency behaviour
+type Config struct {
+	// DefaultTimeout is applied to calls whose context has no deadline
+	DefaultTimeout time.Duration
+
+	// HedgeDelay is how long a read waits before sending a hedged second
+	// attempt; set it near the server's p95 latency. Zero disables hedging.
+	HedgeDelay time.Duration
+
+	// MaxRetries is how many times Unavailable errors are retried
+	MaxRetries int
+
+	// RetryBaseDelay is the first retry's backoff; it doubles per attempt
+	RetryBaseDelay time.Duration
+}
+
+// DefaultConfig returns the recommended client configuration
+func DefaultConfig() Config {
+	return Config{
+		DefaultTimeout: 5 * time.Second,
+		HedgeDelay:     150 * time.Millisecond,
+		MaxRetries:     3,
+		RetryBaseDelay: 100 * time.Millisecond,
+	}
+}
+
+// Client wraps the generated gRPC client with hedged reads, retries on
+// Unavailable and per-call deadline defaults — the latency policy dashboard
+// consumers want without writing it themselves
+type Client struct {
+	grpc   pb.BacklogServiceClient
+	config Config
+}
+
+// New creates a new client over an established connection
+func New(conn *grpc.ClientConn, config Config) *Client {
+	if config.DefaultTimeout <= 0 {
+		config.DefaultTimeout = DefaultConfig().DefaultTimeout
+	}
+	if config.RetryBaseDelay <= 0 {
+		config.RetryBaseDelay = DefaultConfig().RetryBaseDelay
+	}
+	return &Client{
+		grpc:   pb.NewBacklogServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetItem retrieves an item, hedging the read after the configured delay
+func (c *Client) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.BacklogItem, error) {
+	ctx, cancel := c.withDefaultDeadline(ctx)
+	defer cancel()
+	return hedged(ctx, c.config, func(ctx context.Context) (*pb.BacklogItem, error) {
+		return c.grpc.GetItem(ctx, req)
+	})
+}
+
+// ListItems lists items, hedging the read after the configured delay
+func (c *Client) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	ctx, cancel := c.withDefaultDeadline(ctx)
+	defer cancel()
+	return hedged(ctx, c.config, func(ctx context.Context) (*pb.ListItemsResponse, error) {
+		return c.grpc.ListItems(ctx, req)
+	})
+}
+
+// CreateItem creates an item. Writes are retried on Unavailable but never
+// hedged: a hedge could double-apply the write.
+func (c *Client) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.BacklogItem, error) {
+	ctx, cancel := c.withDefaultDeadline(ctx)
+	defer cancel()
+	return retried(ctx, c.config, func(ctx context.Context) (*pb.BacklogItem, error) {
+		return c.grpc.CreateItem(ctx, req)
+	})
+}
+
+// UpdateItem updates an item with retry on Unavailable
+func (c *Client) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.BacklogItem, error) {
+	ctx, cancel := c.withDefaultDeadline(ctx)
+	defer cancel()
+	return retried(ctx, c.config, func(ctx context.Context) (*pb.BacklogItem, error) {
+		return c.grpc.UpdateItem(ctx, req)
+	})
+}
+
+// withDefaultDeadline applies the default timeout when the caller set none
+func (c *Client) withDefaultDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.config.DefaultTimeout)
+}
+
+// hedged runs a read, launching a second attempt if the first hasn't
+// answered within the hedge delay, and returns whichever finishes first
+func hedged[T any](ctx context.Context, config Config, call func(context.Context) (T, error)) (T, error) {
+	if config.HedgeDelay <= 0 {
+		return retried(ctx, config, call)
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	results := make(chan result, 2)
+	attempt := func() {
+		value, err := retried(ctx, config, call)
+		results <- result{value: value, err: err}
+	}
+
+	go attempt()
+
+	timer := time.NewTimer(config.HedgeDelay)
+	defer timer.Stop()
+
+	launched := 1
+	for {
+		select {
+		case <-timer.C:
+			// First attempt is slow: hedge with a second one
+			go attempt()
+			launched++
+		case r := <-results:
+			if r.err == nil || launched == 1 {
+				return r.value, r.err
+			}
+			// First failure with a hedge still in flight: wait for the other
+			launched--
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// retried retries a call with exponential backoff while the server reports
+// Unavailable
+func retried[T any](ctx context.Context, config Config, call func(context.Context) (T, error)) (T, error) {
+	var (
+		value T
+		err   error
+	)
+	delay := config.RetryBaseDelay
+	for attempt := 0; ; attempt++ {
+		value, err = call(ctx)
+		if err == nil || status.Code(err) != codes.Unavailable || attempt >= config.MaxRetries {
+			return value, err
+		}
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+			return value, ctx.Err()
+		}
+	}
+}
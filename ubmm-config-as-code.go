@@ -0,0 +1,219 @@
+// services/backlog-service/internal/domain/service/config_as_code.go
+
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// workspaceConfigVersion is the current version of the exported document
+// format; importers reject documents from a newer format than they know
+const workspaceConfigVersion = 1
+
+// WorkspaceConfig is a workspace's configuration as a versioned document:
+// item types, workflow statuses, estimation scale and board layout
+// (including WIP limits). Exported as YAML so it can live in Git and be
+// applied back with diff/apply semantics.
+type WorkspaceConfig struct {
+	Version  int                         `yaml:"version" json:"version"`
+	Types    []*model.ItemTypeDefinition `yaml:"types" json:"types"`
+	Statuses []*model.StatusDefinition   `yaml:"statuses" json:"statuses"`
+	Scale    *model.EstimationScale      `yaml:"estimationScale" json:"estimationScale"`
+	Board    *BoardConfig                `yaml:"board,omitempty" json:"board,omitempty"`
+}
+
+// Config change sections and actions as reported by Diff and Apply
+const (
+	ConfigSectionTypes    = "types"
+	ConfigSectionStatuses = "statuses"
+	ConfigSectionScale    = "estimation_scale"
+	ConfigSectionBoard    = "board"
+
+	ConfigActionAdd    = "ADD"
+	ConfigActionUpdate = "UPDATE"
+	ConfigActionRemove = "REMOVE"
+)
+
+// ConfigChange is one difference between a configuration document and the
+// live configuration
+type ConfigChange struct {
+	Section string `json:"section"`
+	Action  string `json:"action"`
+	Key     string `json:"key"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// activeBoardConfig is the workspace's board layout; configuration
+// documents replace it the same way the model globals are replaced
+var activeBoardConfig = DefaultBoardConfig
+
+// ActiveBoardConfig returns the board layout in use
+func ActiveBoardConfig() BoardConfig {
+	return activeBoardConfig
+}
+
+// ConfigAsCodeService exports and imports workspace configuration as a
+// versioned YAML document. Apply is declarative: the document is the desired
+// state, and custom types or statuses absent from it are removed.
+type ConfigAsCodeService struct {
+	logger *zap.Logger
+}
+
+// NewConfigAsCodeService creates a new config-as-code service
+func NewConfigAsCodeService(logger *zap.Logger) *ConfigAsCodeService {
+	return &ConfigAsCodeService{logger: logger}
+}
+
+// Export captures the live workspace configuration as a document
+func (s *ConfigAsCodeService) Export() *WorkspaceConfig {
+	types := model.ActiveTypeRegistry().Types()
+	sort.Slice(types, func(i, j int) bool { return types[i].Type < types[j].Type })
+
+	statuses := model.ActiveWorkflow().Statuses()
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Status < statuses[j].Status })
+
+	board := ActiveBoardConfig()
+	return &WorkspaceConfig{
+		Version:  workspaceConfigVersion,
+		Types:    types,
+		Statuses: statuses,
+		Scale:    model.ActiveEstimationScale(),
+		Board:    &board,
+	}
+}
+
+// ExportYAML captures the live workspace configuration as YAML
+func (s *ConfigAsCodeService) ExportYAML() ([]byte, error) {
+	data, err := yaml.Marshal(s.Export())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workspace config: %w", err)
+	}
+	return data, nil
+}
+
+// ParseWorkspaceConfig parses and version-checks a YAML configuration
+// document
+func ParseWorkspaceConfig(data []byte) (*WorkspaceConfig, error) {
+	var config WorkspaceConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace config: %w", err)
+	}
+	if config.Version > workspaceConfigVersion {
+		return nil, fmt.Errorf("workspace config version %d is newer than supported version %d", config.Version, workspaceConfigVersion)
+	}
+	return &config, nil
+}
+
+// Diff compares a configuration document against the live configuration
+// without changing anything, so the plan can be reviewed before Apply
+func (s *ConfigAsCodeService) Diff(incoming *WorkspaceConfig) []ConfigChange {
+	var changes []ConfigChange
+	current := s.Export()
+
+	// Types: additions and updates from the document, removals from absence
+	currentTypes := map[model.ItemType]*model.ItemTypeDefinition{}
+	for _, def := range current.Types {
+		currentTypes[def.Type] = def
+	}
+	incomingTypes := map[model.ItemType]bool{}
+	for _, def := range incoming.Types {
+		incomingTypes[def.Type] = true
+		existing, ok := currentTypes[def.Type]
+		switch {
+		case !ok:
+			changes = append(changes, ConfigChange{Section: ConfigSectionTypes, Action: ConfigActionAdd, Key: string(def.Type)})
+		case !reflect.DeepEqual(existing, def):
+			changes = append(changes, ConfigChange{Section: ConfigSectionTypes, Action: ConfigActionUpdate, Key: string(def.Type)})
+		}
+	}
+	for _, def := range current.Types {
+		if !incomingTypes[def.Type] {
+			changes = append(changes, ConfigChange{Section: ConfigSectionTypes, Action: ConfigActionRemove, Key: string(def.Type)})
+		}
+	}
+
+	// Statuses, same shape
+	currentStatuses := map[model.ItemStatus]*model.StatusDefinition{}
+	for _, def := range current.Statuses {
+		currentStatuses[def.Status] = def
+	}
+	incomingStatuses := map[model.ItemStatus]bool{}
+	for _, def := range incoming.Statuses {
+		incomingStatuses[def.Status] = true
+		existing, ok := currentStatuses[def.Status]
+		switch {
+		case !ok:
+			changes = append(changes, ConfigChange{Section: ConfigSectionStatuses, Action: ConfigActionAdd, Key: string(def.Status)})
+		case !reflect.DeepEqual(existing, def):
+			changes = append(changes, ConfigChange{Section: ConfigSectionStatuses, Action: ConfigActionUpdate, Key: string(def.Status)})
+		}
+	}
+	for _, def := range current.Statuses {
+		if !incomingStatuses[def.Status] {
+			changes = append(changes, ConfigChange{Section: ConfigSectionStatuses, Action: ConfigActionRemove, Key: string(def.Status)})
+		}
+	}
+
+	if incoming.Scale != nil && !reflect.DeepEqual(current.Scale, incoming.Scale) {
+		changes = append(changes, ConfigChange{Section: ConfigSectionScale, Action: ConfigActionUpdate, Key: incoming.Scale.Name})
+	}
+	if incoming.Board != nil && !reflect.DeepEqual(current.Board, incoming.Board) {
+		changes = append(changes, ConfigChange{Section: ConfigSectionBoard, Action: ConfigActionUpdate, Key: "board"})
+	}
+
+	return changes
+}
+
+// Apply makes the live configuration match the document and returns the
+// changes made. Removals of built-in types or statuses are reported with a
+// detail and skipped rather than failing the whole apply.
+func (s *ConfigAsCodeService) Apply(incoming *WorkspaceConfig) ([]ConfigChange, error) {
+	changes := s.Diff(incoming)
+
+	byType := map[model.ItemType]*model.ItemTypeDefinition{}
+	for _, def := range incoming.Types {
+		byType[def.Type] = def
+	}
+	byStatus := map[model.ItemStatus]*model.StatusDefinition{}
+	for _, def := range incoming.Statuses {
+		byStatus[def.Status] = def
+	}
+
+	applied := make([]ConfigChange, 0, len(changes))
+	for _, change := range changes {
+		var err error
+		switch {
+		case change.Section == ConfigSectionTypes && change.Action == ConfigActionRemove:
+			err = model.ActiveTypeRegistry().Remove(model.ItemType(change.Key))
+		case change.Section == ConfigSectionTypes:
+			err = model.ActiveTypeRegistry().Register(byType[model.ItemType(change.Key)])
+		case change.Section == ConfigSectionStatuses && change.Action == ConfigActionRemove:
+			err = model.ActiveWorkflow().Remove(model.ItemStatus(change.Key))
+		case change.Section == ConfigSectionStatuses:
+			err = model.ActiveWorkflow().Register(byStatus[model.ItemStatus(change.Key)])
+		case change.Section == ConfigSectionScale:
+			model.SetEstimationScale(incoming.Scale)
+		case change.Section == ConfigSectionBoard:
+			activeBoardConfig = *incoming.Board
+		}
+
+		if err != nil {
+			change.Detail = err.Error()
+			s.logger.Warn("Skipped config change",
+				zap.String("section", change.Section),
+				zap.String("key", change.Key),
+				zap.Error(err))
+		}
+		applied = append(applied, change)
+	}
+
+	s.logger.Info("Workspace config applied", zap.Int("changes", len(applied)))
+	return applied, nil
+}
@@ -22,6 +22,42 @@ type Config struct {
 	EventBus    KafkaConfig   `mapstructure:"event_bus"`
 	Observability ObservabilityConfig `mapstructure:"observability"`
 	Security    SecurityConfig `mapstructure:"security"`
+	Estimation  EstimationConfig `mapstructure:"estimation"`
+	Workflow    WorkflowConfig `mapstructure:"workflow"`
+	Routing     RoutingConfig `mapstructure:"routing"`
+	Startup     StartupConfig `mapstructure:"startup"`
+}
+
+// StartupConfig holds startup dependency wait behaviour
+type StartupConfig struct {
+	// MaxWait bounds how long startup retries each dependency before
+	// giving up on it
+	MaxWait time.Duration `mapstructure:"max_wait"`
+	// DegradedStart lets the service start serving reads when Kafka stays
+	// down past the wait budget instead of exiting
+	DegradedStart bool `mapstructure:"degraded_start"`
+}
+
+// RoutingConfig holds outbound event routing configuration
+type RoutingConfig struct {
+	// RulesFile points to a JSON file of routing rules; empty disables
+	// routing and events only reach their original topics
+	RulesFile string `mapstructure:"rules_file"`
+}
+
+// WorkflowConfig holds workspace workflow behaviour toggles
+type WorkflowConfig struct {
+	// DeriveParentStart moves a parent to IN_PROGRESS when its first child starts
+	DeriveParentStart bool `mapstructure:"derive_parent_start"`
+	// DeriveParentDone moves a parent to DONE when all its children are done
+	DeriveParentDone bool `mapstructure:"derive_parent_done"`
+}
+
+// EstimationConfig holds the workspace's estimation scale configuration
+type EstimationConfig struct {
+	// Scale selects the story point scale: "fibonacci", "powers_of_two"
+	// or "tshirt"
+	Scale string `mapstructure:"scale"`
 }
 
 // ServerConfig holds configuration for the server
@@ -44,6 +80,9 @@ type DatabaseConfig struct {
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	// SlowQueryThreshold is the query duration above which the adapter
+	// logs and counts the query; zero disables slow query logging
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
 }
 
 // CacheConfig holds configuration for the cache
@@ -68,6 +107,18 @@ type KafkaConfig struct {
 	SASLMechanism    string `mapstructure:"sasl_mechanism"`
 	SASLUsername     string `mapstructure:"sasl_username"`
 	SASLPassword     string `mapstructure:"sasl_password"`
+
+	// Transactional producer settings. When enabled, the adapter wraps
+	// outbox relay batches in Kafka transactions for exactly-once delivery.
+	// When disabled, the producer falls back to idempotent-only mode.
+	TransactionalEnabled bool          `mapstructure:"transactional_enabled"`
+	TransactionalID      string        `mapstructure:"transactional_id"`
+	TransactionTimeout   time.Duration `mapstructure:"transaction_timeout"`
+
+	// Async publish queue settings. PublishQueueSize bounds the in-memory
+	// queue; BackpressurePolicy is "block", "drop_oldest" or "spill".
+	PublishQueueSize   int    `mapstructure:"publish_queue_size"`
+	BackpressurePolicy string `mapstructure:"backpressure_policy"`
 }
 
 // ObservabilityConfig holds configuration for observability
@@ -78,6 +129,9 @@ type ObservabilityConfig struct {
 	TracingEndpoint     string `mapstructure:"tracing_endpoint"`
 	MetricsEnabled      bool   `mapstructure:"metrics_enabled"`
 	MetricsEndpoint     string `mapstructure:"metrics_endpoint"`
+	// SlowRPCThreshold is the RPC duration above which the interceptor
+	// logs and counts the call; zero disables slow RPC logging
+	SlowRPCThreshold    time.Duration `mapstructure:"slow_rpc_threshold"`
 }
 
 // SecurityConfig holds security configuration
@@ -91,6 +145,8 @@ type SecurityConfig struct {
 	EnableRateLimiting   bool          `mapstructure:"enable_rate_limiting"`
 	RateLimitPerSecond   int           `mapstructure:"rate_limit_per_second"`
 	EnableRequestLogging bool          `mapstructure:"enable_request_logging"`
+	// AdminToken protects the embedded ops console; empty disables it
+	AdminToken           string        `mapstructure:"admin_token"`
 }
 
 // Load loads configuration from file and environment variables
@@ -133,6 +189,13 @@ func Load() (*Config, error) {
 
 // setDefaultConfig sets default configuration values
 func setDefaultConfig() {
+	// Estimation defaults
+	viper.SetDefault("estimation.scale", "fibonacci")
+
+	// Workflow defaults: parent status derivation is opt-in
+	viper.SetDefault("workflow.derive_parent_start", false)
+	viper.SetDefault("workflow.derive_parent_done", false)
+
 	// Server defaults
 	viper.SetDefault("server.grpc_port", 8080)
 	viper.SetDefault("server.http_port", 8081)
@@ -150,6 +213,7 @@ func setDefaultConfig() {
 	viper.SetDefault("database.max_open_conns", 25)
 	viper.SetDefault("database.max_idle_conns", 5)
 	viper.SetDefault("database.conn_max_lifetime", 5*time.Minute)
+	viper.SetDefault("database.slow_query_threshold", 200*time.Millisecond)
 
 	// Cache defaults
 	viper.SetDefault("cache.host", "localhost")
@@ -170,6 +234,19 @@ func setDefaultConfig() {
 	viper.SetDefault("event_bus.sasl_mechanism", "")
 	viper.SetDefault("event_bus.sasl_username", "")
 	viper.SetDefault("event_bus.sasl_password", "")
+	viper.SetDefault("event_bus.transactional_enabled", false)
+	viper.SetDefault("event_bus.transactional_id", "")
+	viper.SetDefault("event_bus.transaction_timeout", 60*time.Second)
+	viper.SetDefault("event_bus.publish_queue_size", 1024)
+	viper.SetDefault("event_bus.backpressure_policy", "block")
+
+	// Routing defaults: no rules, events only reach their original topics
+	viper.SetDefault("routing.rules_file", "")
+
+	// Startup defaults: wait up to a minute per dependency, fail hard when
+	// any stays down
+	viper.SetDefault("startup.max_wait", 60*time.Second)
+	viper.SetDefault("startup.degraded_start", false)
 
 	// Observability defaults
 	viper.SetDefault("observability.log_level", "info")
@@ -178,6 +255,7 @@ func setDefaultConfig() {
 	viper.SetDefault("observability.tracing_endpoint", "localhost:4317")
 	viper.SetDefault("observability.metrics_enabled", true)
 	viper.SetDefault("observability.metrics_endpoint", "localhost:9090")
+	viper.SetDefault("observability.slow_rpc_threshold", 500*time.Millisecond)
 
 	// Security defaults
 	viper.SetDefault("security.jwt_secret", "")
@@ -189,6 +267,7 @@ func setDefaultConfig() {
 	viper.SetDefault("security.enable_rate_limiting", true)
 	viper.SetDefault("security.rate_limit_per_second", 100)
 	viper.SetDefault("security.enable_request_logging", true)
+	viper.SetDefault("security.admin_token", "")
 
 	// Environment default
 	viper.SetDefault("environment", "development")
@@ -342,6 +421,16 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("kafka bootstrap servers must be set")
 	}
 
+	// Validate transactional producer settings
+	if config.EventBus.TransactionalEnabled {
+		if config.EventBus.TransactionalID == "" {
+			return fmt.Errorf("kafka transactional ID must be set when transactions are enabled")
+		}
+		if config.EventBus.TransactionTimeout <= 0 {
+			return fmt.Errorf("kafka transaction timeout must be positive")
+		}
+	}
+
 	// Validate security if TLS is enabled
 	if config.Security.EnableTLS {
 		if config.Security.TLSCertFile == "" {
@@ -0,0 +1,295 @@
+// services/backlog-service/pkg/testing/conformance.go
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ConformanceFailure describes one conformance check a repository
+// implementation did not pass
+type ConformanceFailure struct {
+	Check  string
+	Detail string
+}
+
+// BacklogRepositoryFactory builds a fresh, empty repository for one check and
+// returns a cleanup function. A fresh store per check keeps the checks
+// independent, the same way the contract suite replays fixtures in isolation.
+type BacklogRepositoryFactory func() (repository.BacklogRepository, func(), error)
+
+// backlogConformanceChecks is the table of semantics every BacklogRepository
+// implementation must share, regardless of driver
+var backlogConformanceChecks = []struct {
+	name string
+	run  func(ctx context.Context, repo repository.BacklogRepository) error
+}{
+	{"create then get round-trips the item", checkCreateGetRoundTrip},
+	{"get of a missing ID returns an error", checkGetMissing},
+	{"update persists changed fields", checkUpdatePersists},
+	{"delete removes the item", checkDeleteRemoves},
+	{"list filters by status", checkListStatusFilter},
+	{"list reports the unpaginated total", checkListTotal},
+	{"children are returned for their parent only", checkChildrenScoped},
+}
+
+// VerifyBacklogRepository runs every conformance check against repositories
+// built by the factory and reports the failures. New storage adapters run it
+// from their own test suites; an empty result means the driver matches the
+// reference semantics.
+func VerifyBacklogRepository(ctx context.Context, factory BacklogRepositoryFactory) []ConformanceFailure {
+	var failures []ConformanceFailure
+	for _, check := range backlogConformanceChecks {
+		repo, cleanup, err := factory()
+		if err != nil {
+			failures = append(failures, ConformanceFailure{Check: check.name, Detail: fmt.Sprintf("factory failed: %v", err)})
+			continue
+		}
+		if err := check.run(ctx, repo); err != nil {
+			failures = append(failures, ConformanceFailure{Check: check.name, Detail: err.Error()})
+		}
+		cleanup()
+	}
+	return failures
+}
+
+// checkCreateGetRoundTrip verifies a created item reads back unchanged
+func checkCreateGetRoundTrip(ctx context.Context, repo repository.BacklogRepository) error {
+	item, err := model.NewBacklogItem(model.ItemTypeStory, "Conformance story", "Round-trip check")
+	if err != nil {
+		return err
+	}
+	if err := repo.Create(ctx, item); err != nil {
+		return fmt.Errorf("create failed: %v", err)
+	}
+	got, err := repo.GetByID(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("get after create failed: %v", err)
+	}
+	if got.Title != item.Title || got.Type != item.Type || got.Status != item.Status {
+		return fmt.Errorf("round trip changed the item: got %+v", got)
+	}
+	return nil
+}
+
+// checkGetMissing verifies lookups of unknown IDs fail rather than returning
+// a zero item
+func checkGetMissing(ctx context.Context, repo repository.BacklogRepository) error {
+	if _, err := repo.GetByID(ctx, uuid.New()); err == nil {
+		return fmt.Errorf("expected an error for a missing ID, got none")
+	}
+	return nil
+}
+
+// checkUpdatePersists verifies field changes survive an update/get cycle
+func checkUpdatePersists(ctx context.Context, repo repository.BacklogRepository) error {
+	item, err := model.NewBacklogItem(model.ItemTypeStory, "Before update", "")
+	if err != nil {
+		return err
+	}
+	if err := repo.Create(ctx, item); err != nil {
+		return fmt.Errorf("create failed: %v", err)
+	}
+	item.UpdateTitle("After update")
+	if err := repo.Update(ctx, item); err != nil {
+		return fmt.Errorf("update failed: %v", err)
+	}
+	got, err := repo.GetByID(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("get after update failed: %v", err)
+	}
+	if got.Title != "After update" {
+		return fmt.Errorf("update did not persist: title is %q", got.Title)
+	}
+	return nil
+}
+
+// checkDeleteRemoves verifies deleted items stop resolving
+func checkDeleteRemoves(ctx context.Context, repo repository.BacklogRepository) error {
+	item, err := model.NewBacklogItem(model.ItemTypeStory, "To delete", "")
+	if err != nil {
+		return err
+	}
+	if err := repo.Create(ctx, item); err != nil {
+		return fmt.Errorf("create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, item.ID); err != nil {
+		return fmt.Errorf("delete failed: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, item.ID); err == nil {
+		return fmt.Errorf("item still resolves after delete")
+	}
+	return nil
+}
+
+// checkListStatusFilter verifies status filtering excludes other statuses
+func checkListStatusFilter(ctx context.Context, repo repository.BacklogRepository) error {
+	kept, err := model.NewBacklogItem(model.ItemTypeStory, "Stays NEW", "")
+	if err != nil {
+		return err
+	}
+	moved, err := model.NewBacklogItem(model.ItemTypeStory, "Becomes READY", "")
+	if err != nil {
+		return err
+	}
+	if err := moved.UpdateStatus(model.ItemStatusReady); err != nil {
+		return err
+	}
+	for _, item := range []*model.BacklogItem{kept, moved} {
+		if err := repo.Create(ctx, item); err != nil {
+			return fmt.Errorf("create failed: %v", err)
+		}
+	}
+
+	items, _, err := repo.List(ctx, repository.BacklogFilter{
+		Statuses: []model.ItemStatus{model.ItemStatusReady},
+		Limit:    10,
+	})
+	if err != nil {
+		return fmt.Errorf("list failed: %v", err)
+	}
+	for _, item := range items {
+		if item.Status != model.ItemStatusReady {
+			return fmt.Errorf("status filter leaked a %s item", item.Status)
+		}
+	}
+	if len(items) != 1 {
+		return fmt.Errorf("expected 1 READY item, got %d", len(items))
+	}
+	return nil
+}
+
+// checkListTotal verifies the total counts all matches, not just the page
+func checkListTotal(ctx context.Context, repo repository.BacklogRepository) error {
+	for i := 0; i < 3; i++ {
+		item, err := model.NewBacklogItem(model.ItemTypeStory, fmt.Sprintf("Paged item %d", i), "")
+		if err != nil {
+			return err
+		}
+		if err := repo.Create(ctx, item); err != nil {
+			return fmt.Errorf("create failed: %v", err)
+		}
+	}
+
+	items, total, err := repo.List(ctx, repository.BacklogFilter{Limit: 2})
+	if err != nil {
+		return fmt.Errorf("list failed: %v", err)
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected a page of 2, got %d", len(items))
+	}
+	if total != 3 {
+		return fmt.Errorf("expected total 3, got %d", total)
+	}
+	return nil
+}
+
+// checkChildrenScoped verifies GetChildren returns only the asked-for
+// parent's children
+func checkChildrenScoped(ctx context.Context, repo repository.BacklogRepository) error {
+	parent, err := model.NewBacklogItem(model.ItemTypeEpic, "Parent epic", "")
+	if err != nil {
+		return err
+	}
+	other, err := model.NewBacklogItem(model.ItemTypeEpic, "Other epic", "")
+	if err != nil {
+		return err
+	}
+	child, err := model.NewBacklogItem(model.ItemTypeFeature, "Child feature", "")
+	if err != nil {
+		return err
+	}
+	child.ParentID = &parent.ID
+	for _, item := range []*model.BacklogItem{parent, other, child} {
+		if err := repo.Create(ctx, item); err != nil {
+			return fmt.Errorf("create failed: %v", err)
+		}
+	}
+
+	children, err := repo.GetChildren(ctx, parent.ID)
+	if err != nil {
+		return fmt.Errorf("get children failed: %v", err)
+	}
+	if len(children) != 1 || children[0].ID != child.ID {
+		return fmt.Errorf("expected exactly the one child, got %d items", len(children))
+	}
+	none, err := repo.GetChildren(ctx, other.ID)
+	if err != nil {
+		return fmt.Errorf("get children of childless parent failed: %v", err)
+	}
+	if len(none) != 0 {
+		return fmt.Errorf("childless parent returned %d children", len(none))
+	}
+	return nil
+}
+
+// EventRepositoryFactory builds a fresh, empty event repository for one
+// check and returns a cleanup function
+type EventRepositoryFactory func() (repository.EventRepository, func(), error)
+
+// eventConformanceChecks is the table of semantics every EventRepository
+// implementation must share
+var eventConformanceChecks = []struct {
+	name string
+	run  func(ctx context.Context, repo repository.EventRepository) error
+}{
+	{"stored events read back by item ID", checkEventRoundTrip},
+	{"an item with no events yields an empty history", checkEventEmptyHistory},
+}
+
+// VerifyEventRepository runs every event store conformance check against
+// repositories built by the factory and reports the failures
+func VerifyEventRepository(ctx context.Context, factory EventRepositoryFactory) []ConformanceFailure {
+	var failures []ConformanceFailure
+	for _, check := range eventConformanceChecks {
+		repo, cleanup, err := factory()
+		if err != nil {
+			failures = append(failures, ConformanceFailure{Check: check.name, Detail: fmt.Sprintf("factory failed: %v", err)})
+			continue
+		}
+		if err := check.run(ctx, repo); err != nil {
+			failures = append(failures, ConformanceFailure{Check: check.name, Detail: err.Error()})
+		}
+		cleanup()
+	}
+	return failures
+}
+
+// checkEventRoundTrip verifies a stored event appears in the item's history
+func checkEventRoundTrip(ctx context.Context, repo repository.EventRepository) error {
+	item, err := model.NewBacklogItem(model.ItemTypeStory, "Event source", "")
+	if err != nil {
+		return err
+	}
+	if err := repo.StoreEvent(ctx, event.NewItemCreatedEvent(item.ID, item)); err != nil {
+		return fmt.Errorf("store event failed: %v", err)
+	}
+	events, err := repo.GetEventsByItemID(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("get events failed: %v", err)
+	}
+	if len(events) != 1 {
+		return fmt.Errorf("expected 1 event, got %d", len(events))
+	}
+	return nil
+}
+
+// checkEventEmptyHistory verifies unknown items have empty, not erroring,
+// histories
+func checkEventEmptyHistory(ctx context.Context, repo repository.EventRepository) error {
+	events, err := repo.GetEventsByItemID(ctx, uuid.New())
+	if err != nil {
+		return fmt.Errorf("get events for unknown item failed: %v", err)
+	}
+	if len(events) != 0 {
+		return fmt.Errorf("unknown item returned %d events", len(events))
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// services/backlog-service/internal/domain/integration/connector.go
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// FieldMapping maps a backlog item field to a connector-specific field name
+type FieldMapping map[string]string
+
+// Connector is the interface every external tool integration implements.
+// Keeping the surface to Push/Pull/HandleWebhook/MapFields means a new
+// connector is a few hundred lines instead of a bespoke code path.
+type Connector interface {
+	// Name returns the unique connector name, e.g. "jira", "github", "ado"
+	Name() string
+
+	// Push writes the local item state to the external system
+	Push(ctx context.Context, item *model.BacklogItem) error
+
+	// Pull reads the external state for an item and returns the updated item,
+	// or nil if the external system has no newer state
+	Pull(ctx context.Context, item *model.BacklogItem) (*model.BacklogItem, error)
+
+	// HandleWebhook processes an inbound webhook payload from the external system
+	HandleWebhook(ctx context.Context, payload []byte, headers map[string]string) error
+
+	// MapFields returns the connector's field mapping for reporting and
+	// conflict attribution
+	MapFields() FieldMapping
+}
+
+// WorkspaceConfig holds the stored configuration of one connector for one
+// workspace. Settings is connector-specific JSON.
+type WorkspaceConfig struct {
+	WorkspaceID uuid.UUID       `json:"workspaceId"`
+	Connector   string          `json:"connector"`
+	Enabled     bool            `json:"enabled"`
+	Settings    json.RawMessage `json:"settings"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// ConfigStore persists per-workspace connector configuration
+type ConfigStore interface {
+	// Get retrieves the configuration for a connector in a workspace
+	Get(ctx context.Context, workspaceID uuid.UUID, connector string) (*WorkspaceConfig, error)
+
+	// List retrieves all connector configurations for a workspace
+	List(ctx context.Context, workspaceID uuid.UUID) ([]*WorkspaceConfig, error)
+
+	// Save stores a connector configuration
+	Save(ctx context.Context, cfg *WorkspaceConfig) error
+}
+
+// SyncState describes the last sync outcome for an item on one connector
+type SyncState string
+
+const (
+	// SyncStateSynced means the last sync completed successfully
+	SyncStateSynced SyncState = "SYNCED"
+	// SyncStatePending means a sync is queued or in flight
+	SyncStatePending SyncState = "PENDING"
+	// SyncStateError means the last sync failed
+	SyncStateError SyncState = "ERROR"
+)
+
+// SyncStatus is the per-item, per-connector sync record surfaced by the
+// sync-status API
+type SyncStatus struct {
+	ItemID       uuid.UUID  `json:"itemId"`
+	Connector    string     `json:"connector"`
+	State        SyncState  `json:"state"`
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
+
+// SyncStatusStore persists sync status records
+type SyncStatusStore interface {
+	// Get retrieves the sync status for an item on a connector
+	Get(ctx context.Context, itemID uuid.UUID, connector string) (*SyncStatus, error)
+
+	// GetByItem retrieves all sync statuses for an item
+	GetByItem(ctx context.Context, itemID uuid.UUID) ([]*SyncStatus, error)
+
+	// Save stores a sync status record
+	Save(ctx context.Context, status *SyncStatus) error
+}
+
+// RetryPolicy holds the shared retry/backoff settings applied to every
+// connector call
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used when none is configured
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// Registry holds the registered connectors and drives sync operations through
+// the shared retry and status-tracking machinery
+type Registry struct {
+	connectors map[string]Connector
+	configs    ConfigStore
+	statuses   SyncStatusStore
+	retry      RetryPolicy
+	logger     *zap.Logger
+}
+
+// NewRegistry creates a new connector registry
+func NewRegistry(configs ConfigStore, statuses SyncStatusStore, retry RetryPolicy, logger *zap.Logger) *Registry {
+	if retry.MaxAttempts <= 0 {
+		retry = DefaultRetryPolicy
+	}
+	return &Registry{
+		connectors: make(map[string]Connector),
+		configs:    configs,
+		statuses:   statuses,
+		retry:      retry,
+		logger:     logger,
+	}
+}
+
+// Register adds a connector to the registry
+func (r *Registry) Register(c Connector) error {
+	if _, exists := r.connectors[c.Name()]; exists {
+		return fmt.Errorf("connector %q already registered", c.Name())
+	}
+	r.connectors[c.Name()] = c
+	return nil
+}
+
+// Get returns a registered connector by name
+func (r *Registry) Get(name string) (Connector, error) {
+	c, ok := r.connectors[name]
+	if !ok {
+		return nil, fmt.Errorf("connector %q not registered", name)
+	}
+	return c, nil
+}
+
+// Names returns the registered connector names, sorted
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.connectors))
+	for name := range r.connectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Push pushes an item through a connector with shared retry handling and
+// records the sync status
+func (r *Registry) Push(ctx context.Context, connector string, item *model.BacklogItem) error {
+	c, err := r.Get(connector)
+	if err != nil {
+		return err
+	}
+
+	r.markPending(ctx, item.ID, connector)
+	err = r.withRetry(ctx, func() error {
+		return c.Push(ctx, item)
+	})
+	r.recordResult(ctx, item.ID, connector, err)
+	return err
+}
+
+// Pull pulls an item through a connector with shared retry handling and
+// records the sync status
+func (r *Registry) Pull(ctx context.Context, connector string, item *model.BacklogItem) (*model.BacklogItem, error) {
+	c, err := r.Get(connector)
+	if err != nil {
+		return nil, err
+	}
+
+	r.markPending(ctx, item.ID, connector)
+	var updated *model.BacklogItem
+	err = r.withRetry(ctx, func() error {
+		var pullErr error
+		updated, pullErr = c.Pull(ctx, item)
+		return pullErr
+	})
+	r.recordResult(ctx, item.ID, connector, err)
+	return updated, err
+}
+
+// ItemStatus returns all sync statuses for an item (the sync-status API)
+func (r *Registry) ItemStatus(ctx context.Context, itemID uuid.UUID) ([]*SyncStatus, error) {
+	return r.statuses.GetByItem(ctx, itemID)
+}
+
+// withRetry runs fn with exponential backoff per the shared retry policy
+func (r *Registry) withRetry(ctx context.Context, fn func() error) error {
+	var lastErr error
+	delay := r.retry.BaseDelay
+
+	for attempt := 1; attempt <= r.retry.MaxAttempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if attempt == r.retry.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > r.retry.MaxDelay {
+			delay = r.retry.MaxDelay
+		}
+	}
+
+	return fmt.Errorf("sync failed after %d attempts: %w", r.retry.MaxAttempts, lastErr)
+}
+
+// markPending records that a sync is in flight
+func (r *Registry) markPending(ctx context.Context, itemID uuid.UUID, connector string) {
+	status := &SyncStatus{
+		ItemID:    itemID,
+		Connector: connector,
+		State:     SyncStatePending,
+	}
+	if err := r.statuses.Save(ctx, status); err != nil {
+		r.logger.Error("Failed to record pending sync status", zap.Error(err))
+	}
+}
+
+// recordResult records the outcome of a sync attempt
+func (r *Registry) recordResult(ctx context.Context, itemID uuid.UUID, connector string, syncErr error) {
+	now := time.Now().UTC()
+	status := &SyncStatus{
+		ItemID:    itemID,
+		Connector: connector,
+	}
+	if syncErr != nil {
+		status.State = SyncStateError
+		status.Error = syncErr.Error()
+	} else {
+		status.State = SyncStateSynced
+		status.LastSyncedAt = &now
+	}
+	if err := r.statuses.Save(ctx, status); err != nil {
+		r.logger.Error("Failed to record sync status", zap.Error(err))
+	}
+}
@@ -0,0 +1,24 @@
+-- services/backlog-service/migrations/000018_cross_workspace_links.up.sql
+
+-- Typed links from a local item to an item in another workspace. The target
+-- is addressed by workspace + item ID with no foreign key: row-level
+-- security scopes this service to one workspace, so the target row is not
+-- visible from here.
+CREATE TABLE IF NOT EXISTS cross_workspace_links (
+    id UUID PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES backlog_items(id) ON DELETE CASCADE,
+    target_workspace_id UUID NOT NULL,
+    target_item_id UUID NOT NULL,
+    link_type TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (item_id, target_workspace_id, target_item_id, link_type)
+);
+
+-- Inbound lookups: which local items do other workspaces point at
+CREATE INDEX IF NOT EXISTS idx_cross_workspace_links_target
+    ON cross_workspace_links(target_item_id);
+
+-- services/backlog-service/migrations/000018_cross_workspace_links.down.sql
+
+DROP TABLE IF EXISTS cross_workspace_links;
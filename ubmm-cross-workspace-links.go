@@ -0,0 +1,296 @@
+// services/backlog-service/internal/domain/service/cross_workspace_links.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// CrossWorkspaceLinkType defines the kind of relationship a cross-workspace
+// link expresses
+type CrossWorkspaceLinkType string
+
+const (
+	// CrossLinkDependsOn means the local item depends on the target
+	CrossLinkDependsOn CrossWorkspaceLinkType = "DEPENDS_ON"
+	// CrossLinkDuplicates means the local item duplicates the target
+	CrossLinkDuplicates CrossWorkspaceLinkType = "DUPLICATES"
+)
+
+// crossGraphMaxDepth bounds how many blocking hops the dependency graph
+// follows from the starting item
+const crossGraphMaxDepth = 10
+
+// CrossWorkspaceLink is a typed link from a local item to an item in
+// another workspace
+type CrossWorkspaceLink struct {
+	ID                uuid.UUID              `json:"id"`
+	ItemID            uuid.UUID              `json:"itemId"`
+	TargetWorkspaceID uuid.UUID              `json:"targetWorkspaceId"`
+	TargetItemID      uuid.UUID              `json:"targetItemId"`
+	Type              CrossWorkspaceLinkType `json:"type"`
+	CreatedBy         string                 `json:"createdBy"`
+	CreatedAt         time.Time              `json:"createdAt"`
+}
+
+// LinkedItemStub is what link resolution shows of a target item. When the
+// caller cannot read the target workspace, Accessible is false and only the
+// IDs are filled in.
+type LinkedItemStub struct {
+	ItemID      uuid.UUID        `json:"itemId"`
+	WorkspaceID uuid.UUID        `json:"workspaceId"`
+	Title       string           `json:"title,omitempty"`
+	Status      model.ItemStatus `json:"status,omitempty"`
+	Accessible  bool             `json:"accessible"`
+}
+
+// ResolvedLink pairs a link with what the caller is allowed to see of its
+// target
+type ResolvedLink struct {
+	Link   *CrossWorkspaceLink `json:"link"`
+	Target *LinkedItemStub     `json:"target"`
+}
+
+// CrossWorkspaceLinkRepository persists cross-workspace links
+type CrossWorkspaceLinkRepository interface {
+	// CreateLink stores a new link
+	CreateLink(ctx context.Context, link *CrossWorkspaceLink) error
+
+	// DeleteLink removes a link
+	DeleteLink(ctx context.Context, linkID uuid.UUID) error
+
+	// GetLinksByItemID retrieves all links from a local item
+	GetLinksByItemID(ctx context.Context, itemID uuid.UUID) ([]*CrossWorkspaceLink, error)
+}
+
+// CrossWorkspaceReader reads items in other workspaces under the caller's
+// permissions; a read failing for any reason resolves to a stub
+type CrossWorkspaceReader interface {
+	// GetItem retrieves an item from another workspace as the caller
+	GetItem(ctx context.Context, workspaceID, itemID uuid.UUID) (*model.BacklogItem, error)
+}
+
+// DependencyNode is one item in a dependency graph. Cross-workspace targets
+// the caller cannot read appear with Stub true and only their IDs filled in.
+type DependencyNode struct {
+	ItemID      uuid.UUID        `json:"itemId"`
+	WorkspaceID uuid.UUID        `json:"workspaceId,omitempty"` // zero for the local workspace
+	Title       string           `json:"title,omitempty"`
+	Status      model.ItemStatus `json:"status,omitempty"`
+	Stub        bool             `json:"stub,omitempty"`
+}
+
+// DependencyEdge is one dependency between two items in the graph
+type DependencyEdge struct {
+	From           uuid.UUID `json:"from"`
+	To             uuid.UUID `json:"to"`
+	Type           string    `json:"type"`
+	CrossWorkspace bool      `json:"crossWorkspace,omitempty"`
+}
+
+// DependencyGraph is an item's dependencies: local blocking chains plus
+// cross-workspace depends-on links
+type DependencyGraph struct {
+	Nodes []*DependencyNode `json:"nodes"`
+	Edges []*DependencyEdge `json:"edges"`
+}
+
+// CrossWorkspaceLinkService manages typed links between items in different
+// workspaces and folds them into the dependency graph. Resolution is
+// permission-aware: targets the caller cannot read come back as stubs
+// rather than leaking titles across workspace boundaries.
+type CrossWorkspaceLinkService struct {
+	backlog *BacklogService
+	links   CrossWorkspaceLinkRepository
+	reader  CrossWorkspaceReader
+	logger  *zap.Logger
+}
+
+// NewCrossWorkspaceLinkService creates a new cross-workspace link service.
+// reader may be nil, in which case every target resolves to a stub.
+func NewCrossWorkspaceLinkService(backlog *BacklogService, links CrossWorkspaceLinkRepository, reader CrossWorkspaceReader, logger *zap.Logger) *CrossWorkspaceLinkService {
+	return &CrossWorkspaceLinkService{
+		backlog: backlog,
+		links:   links,
+		reader:  reader,
+		logger:  logger,
+	}
+}
+
+// LinkItems creates a typed link from a local item to an item in another
+// workspace
+func (s *CrossWorkspaceLinkService) LinkItems(ctx context.Context, itemID, targetWorkspaceID, targetItemID uuid.UUID, linkType CrossWorkspaceLinkType, createdBy string) (*CrossWorkspaceLink, error) {
+	if linkType != CrossLinkDependsOn && linkType != CrossLinkDuplicates {
+		return nil, errors.New("link type must be DEPENDS_ON or DUPLICATES")
+	}
+	if itemID == targetItemID {
+		return nil, errors.New("an item cannot link to itself")
+	}
+
+	// Make sure the local item exists; the target is in another workspace
+	// and is only checked at resolution time
+	if _, err := s.backlog.repo.GetByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	link := &CrossWorkspaceLink{
+		ID:                uuid.New(),
+		ItemID:            itemID,
+		TargetWorkspaceID: targetWorkspaceID,
+		TargetItemID:      targetItemID,
+		Type:              linkType,
+		CreatedBy:         createdBy,
+		CreatedAt:         time.Now().UTC(),
+	}
+	if err := s.links.CreateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// UnlinkItems removes a cross-workspace link
+func (s *CrossWorkspaceLinkService) UnlinkItems(ctx context.Context, linkID uuid.UUID) error {
+	return s.links.DeleteLink(ctx, linkID)
+}
+
+// GetLinks retrieves an item's cross-workspace links with their targets
+// resolved as far as the caller's permissions allow
+func (s *CrossWorkspaceLinkService) GetLinks(ctx context.Context, itemID uuid.UUID) ([]*ResolvedLink, error) {
+	links, err := s.links.GetLinksByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]*ResolvedLink, 0, len(links))
+	for _, link := range links {
+		resolved = append(resolved, &ResolvedLink{
+			Link:   link,
+			Target: s.resolveTarget(ctx, link),
+		})
+	}
+	return resolved, nil
+}
+
+// resolveTarget reads a link's target as the caller; any failure — no
+// reader, no access, target deleted — resolves to an inaccessible stub
+func (s *CrossWorkspaceLinkService) resolveTarget(ctx context.Context, link *CrossWorkspaceLink) *LinkedItemStub {
+	stub := &LinkedItemStub{
+		ItemID:      link.TargetItemID,
+		WorkspaceID: link.TargetWorkspaceID,
+	}
+	if s.reader == nil {
+		return stub
+	}
+
+	target, err := s.reader.GetItem(ctx, link.TargetWorkspaceID, link.TargetItemID)
+	if err != nil {
+		s.logger.Debug("Cross-workspace link target not readable",
+			zap.String("linkId", link.ID.String()),
+			zap.Error(err))
+		return stub
+	}
+
+	stub.Title = target.Title
+	stub.Status = target.Status
+	stub.Accessible = true
+	return stub
+}
+
+// GetDependencyGraph builds an item's dependency graph: the local blocking
+// chain followed through BlockedByID, plus each visited item's
+// cross-workspace depends-on links
+func (s *CrossWorkspaceLinkService) GetDependencyGraph(ctx context.Context, itemID uuid.UUID) (*DependencyGraph, error) {
+	graph := &DependencyGraph{}
+	visited := make(map[uuid.UUID]bool)
+
+	// Walk the local blocking chain breadth-first, bounded so a reference
+	// cycle cannot loop forever
+	queue := []uuid.UUID{itemID}
+	for depth := 0; depth < crossGraphMaxDepth && len(queue) > 0; depth++ {
+		var next []uuid.UUID
+		for _, id := range queue {
+			if visited[id] {
+				continue
+			}
+			visited[id] = true
+
+			item, err := s.backlog.repo.GetByID(ctx, id)
+			if err != nil {
+				if id == itemID {
+					return nil, err
+				}
+				s.logger.Error("Failed to load item for dependency graph",
+					zap.String("itemId", id.String()),
+					zap.Error(err))
+				continue
+			}
+
+			graph.Nodes = append(graph.Nodes, &DependencyNode{
+				ItemID: item.ID,
+				Title:  item.Title,
+				Status: item.Status,
+			})
+
+			if item.BlockedByID != nil {
+				graph.Edges = append(graph.Edges, &DependencyEdge{
+					From: item.ID,
+					To:   *item.BlockedByID,
+					Type: "BLOCKED_BY",
+				})
+				next = append(next, *item.BlockedByID)
+			}
+
+			if err := s.appendCrossEdges(ctx, item.ID, graph, visited); err != nil {
+				return nil, err
+			}
+		}
+		queue = next
+	}
+
+	return graph, nil
+}
+
+// appendCrossEdges adds an item's cross-workspace depends-on links to the
+// graph, resolving each target to a node or a stub
+func (s *CrossWorkspaceLinkService) appendCrossEdges(ctx context.Context, itemID uuid.UUID, graph *DependencyGraph, visited map[uuid.UUID]bool) error {
+	links, err := s.links.GetLinksByItemID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.Type != CrossLinkDependsOn {
+			continue
+		}
+
+		graph.Edges = append(graph.Edges, &DependencyEdge{
+			From:           link.ItemID,
+			To:             link.TargetItemID,
+			Type:           string(link.Type),
+			CrossWorkspace: true,
+		})
+
+		if visited[link.TargetItemID] {
+			continue
+		}
+		visited[link.TargetItemID] = true
+
+		target := s.resolveTarget(ctx, link)
+		graph.Nodes = append(graph.Nodes, &DependencyNode{
+			ItemID:      target.ItemID,
+			WorkspaceID: target.WorkspaceID,
+			Title:       target.Title,
+			Status:      target.Status,
+			Stub:        !target.Accessible,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// services/backlog-service/internal/domain/devlink/devlink.go
+
+package devlink
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// LinkType defines the kind of development artifact linked to an item
+type LinkType string
+
+const (
+	// LinkTypeCommit represents a linked Git commit
+	LinkTypeCommit LinkType = "COMMIT"
+	// LinkTypePullRequest represents a linked pull/merge request
+	LinkTypePullRequest LinkType = "PULL_REQUEST"
+)
+
+// DevLink associates a backlog item with a Git commit or pull request
+type DevLink struct {
+	ID        uuid.UUID `json:"id"`
+	ItemID    uuid.UUID `json:"itemId"`
+	Type      LinkType  `json:"type"`
+	Provider  string    `json:"provider"` // e.g. "github", "gitlab"
+	Repo      string    `json:"repo"`
+	Ref       string    `json:"ref"`   // commit SHA or PR number
+	Title     string    `json:"title"` // commit subject or PR title
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Repository defines the interface for dev link persistence
+type Repository interface {
+	// Create stores a new dev link
+	Create(ctx context.Context, link *DevLink) error
+
+	// GetByItemID retrieves all dev links for an item
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*DevLink, error)
+
+	// Exists checks whether a link for the same artifact already exists
+	Exists(ctx context.Context, itemID uuid.UUID, linkType LinkType, provider, repo, ref string) (bool, error)
+}
+
+// TransitionRule maps a merged pull request to an automatic status change
+type TransitionRule struct {
+	// FromStatuses limits which current statuses the rule applies to;
+	// empty means any status
+	FromStatuses []model.ItemStatus
+
+	// ToStatus is the status applied when a linked PR merges
+	ToStatus model.ItemStatus
+}
+
+// itemKeyPattern matches "UBMM-123" style item keys in commit messages,
+// branch names and PR titles
+var itemKeyPattern = regexp.MustCompile(`\bUBMM-(\d+)\b`)
+
+// ItemKeyResolver resolves a human-readable item key to the item's UUID
+type ItemKeyResolver interface {
+	// ResolveKey returns the item ID for a "UBMM-123" style key
+	ResolveKey(ctx context.Context, key string) (uuid.UUID, error)
+}
+
+// Service implements dev link management and merge-driven auto transitions
+type Service struct {
+	links     Repository
+	repo      repository.BacklogRepository
+	resolver  ItemKeyResolver
+	mergeRule *TransitionRule
+	logger    *zap.Logger
+}
+
+// NewService creates a new dev link service. mergeRule may be nil to disable
+// auto transitions on PR merge.
+func NewService(links Repository, repo repository.BacklogRepository, resolver ItemKeyResolver, mergeRule *TransitionRule, logger *zap.Logger) *Service {
+	return &Service{
+		links:     links,
+		repo:      repo,
+		resolver:  resolver,
+		mergeRule: mergeRule,
+		logger:    logger,
+	}
+}
+
+// ExtractItemKeys returns all "UBMM-123" style keys found in the given text
+func ExtractItemKeys(text string) []string {
+	matches := itemKeyPattern.FindAllString(text, -1)
+	seen := make(map[string]bool)
+	var keys []string
+	for _, key := range matches {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// LinkArtifact records a link between an item and a commit or PR. Duplicate
+// links for the same artifact are silently ignored.
+func (s *Service) LinkArtifact(ctx context.Context, itemID uuid.UUID, linkType LinkType, provider, repo, ref, title, url string) (*DevLink, error) {
+	// Make sure the item exists
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.links.Exists(ctx, itemID, linkType, provider, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, nil
+	}
+
+	link := &DevLink{
+		ID:        uuid.New(),
+		ItemID:    itemID,
+		Type:      linkType,
+		Provider:  provider,
+		Repo:      repo,
+		Ref:       ref,
+		Title:     title,
+		URL:       url,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.links.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// GetLinks retrieves all dev links for an item
+func (s *Service) GetLinks(ctx context.Context, itemID uuid.UUID) ([]*DevLink, error) {
+	return s.links.GetByItemID(ctx, itemID)
+}
+
+// ProcessPushEvent links commits to items referenced in their messages
+func (s *Service) ProcessPushEvent(ctx context.Context, provider, repo string, commits []CommitRef) error {
+	for _, commit := range commits {
+		for _, itemID := range s.resolveReferences(ctx, commit.Message) {
+			_, err := s.LinkArtifact(ctx, itemID, LinkTypeCommit, provider, repo, commit.SHA, commit.Message, commit.URL)
+			if err != nil {
+				s.logger.Error("Failed to link commit",
+					zap.String("sha", commit.SHA),
+					zap.String("itemId", itemID.String()),
+					zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// ProcessPullRequestEvent links a PR to referenced items and, when the PR is
+// merged, applies the configured auto-transition rule
+func (s *Service) ProcessPullRequestEvent(ctx context.Context, pr PullRequestRef) error {
+	itemIDs := s.resolveReferences(ctx, pr.Title+" "+pr.Branch+" "+pr.Body)
+
+	for _, itemID := range itemIDs {
+		_, err := s.LinkArtifact(ctx, itemID, LinkTypePullRequest, pr.Provider, pr.Repo, pr.Number, pr.Title, pr.URL)
+		if err != nil {
+			s.logger.Error("Failed to link pull request",
+				zap.String("pr", pr.Number),
+				zap.String("itemId", itemID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		if pr.Merged && s.mergeRule != nil {
+			if err := s.applyMergeTransition(ctx, itemID); err != nil {
+				s.logger.Error("Failed to auto-transition item on PR merge",
+					zap.String("itemId", itemID.String()),
+					zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyMergeTransition moves an item to the configured status if its current
+// status matches the rule
+func (s *Service) applyMergeTransition(ctx context.Context, itemID uuid.UUID) error {
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	if len(s.mergeRule.FromStatuses) > 0 {
+		allowed := false
+		for _, status := range s.mergeRule.FromStatuses {
+			if item.Status == status {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil
+		}
+	}
+
+	if err := item.UpdateStatus(s.mergeRule.ToStatus); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, item)
+}
+
+// resolveReferences turns item keys and external IDs found in text into item IDs
+func (s *Service) resolveReferences(ctx context.Context, text string) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var itemIDs []uuid.UUID
+
+	for _, key := range ExtractItemKeys(text) {
+		itemID, err := s.resolver.ResolveKey(ctx, key)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				s.logger.Debug("Unresolved item key", zap.String("key", key))
+			}
+			continue
+		}
+		if !seen[itemID] {
+			seen[itemID] = true
+			itemIDs = append(itemIDs, itemID)
+		}
+	}
+
+	return itemIDs
+}
+
+// CommitRef describes a commit received from a Git provider webhook
+type CommitRef struct {
+	SHA     string
+	Message string
+	URL     string
+}
+
+// PullRequestRef describes a pull request received from a Git provider webhook
+type PullRequestRef struct {
+	Provider string
+	Repo     string
+	Number   string
+	Title    string
+	Body     string
+	Branch   string
+	URL      string
+	Merged   bool
+}
@@ -0,0 +1,154 @@
+// services/backlog-service/internal/domain/service/discipline_effort.go
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// Discipline identifies a skill area effort is estimated against
+type Discipline string
+
+const (
+	// DisciplineBackend represents backend engineering effort
+	DisciplineBackend Discipline = "BACKEND"
+	// DisciplineFrontend represents frontend engineering effort
+	DisciplineFrontend Discipline = "FRONTEND"
+	// DisciplineQA represents quality assurance effort
+	DisciplineQA Discipline = "QA"
+	// DisciplineDesign represents design effort
+	DisciplineDesign Discipline = "DESIGN"
+)
+
+// EffortBreakdown maps disciplines to estimated effort in story points
+type EffortBreakdown map[Discipline]int
+
+// EffortRepository persists per-item discipline effort estimates
+type EffortRepository interface {
+	// Set stores the effort breakdown for an item
+	Set(ctx context.Context, itemID uuid.UUID, breakdown EffortBreakdown) error
+
+	// Get retrieves the effort breakdown for an item
+	Get(ctx context.Context, itemID uuid.UUID) (EffortBreakdown, error)
+
+	// SumByItems aggregates effort breakdowns across a set of items
+	SumByItems(ctx context.Context, itemIDs []uuid.UUID) (EffortBreakdown, error)
+
+	// CompletedPerWeek retrieves completed effort per discipline per week
+	// over the time window
+	CompletedPerWeek(ctx context.Context, timeWindowWeeks int) (map[Discipline]float64, error)
+
+	// AddedPerWeek retrieves added effort per discipline per week over the
+	// time window
+	AddedPerWeek(ctx context.Context, timeWindowWeeks int) (map[Discipline]float64, error)
+}
+
+// DisciplineCapacity compares demand and completion for one discipline
+type DisciplineCapacity struct {
+	AddedPerWeek     float64 `json:"addedPerWeek"`
+	CompletedPerWeek float64 `json:"completedPerWeek"`
+	NetPerWeek       float64 `json:"netPerWeek"`
+}
+
+// SetEffortBreakdown records the discipline effort split for a story
+func (s *BacklogService) SetEffortBreakdown(ctx context.Context, effortRepo EffortRepository, itemID uuid.UUID, breakdown EffortBreakdown) error {
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+	if item.Type != model.ItemTypeStory {
+		return errors.New("effort breakdowns apply to stories")
+	}
+	for discipline, points := range breakdown {
+		if points < 0 {
+			return errors.New("effort cannot be negative")
+		}
+		switch discipline {
+		case DisciplineBackend, DisciplineFrontend, DisciplineQA, DisciplineDesign:
+		default:
+			return errors.New("unknown discipline: " + string(discipline))
+		}
+	}
+
+	if err := effortRepo.Set(ctx, itemID, breakdown); err != nil {
+		return err
+	}
+
+	s.cache.Delete(ctx, "item:"+itemID.String())
+	return nil
+}
+
+// GetEpicEffortRollup aggregates discipline effort across all stories under
+// an epic (via its features)
+func (s *BacklogService) GetEpicEffortRollup(ctx context.Context, effortRepo EffortRepository, epicID uuid.UUID) (EffortBreakdown, error) {
+	epic, err := s.repo.GetByID(ctx, epicID)
+	if err != nil {
+		return nil, err
+	}
+	if epic.Type != model.ItemTypeEpic {
+		return nil, errors.New("item is not an epic")
+	}
+
+	// Collect the story IDs two levels down
+	features, err := s.repo.GetChildren(ctx, epicID)
+	if err != nil {
+		return nil, err
+	}
+	var storyIDs []uuid.UUID
+	for _, feature := range features {
+		stories, err := s.repo.GetChildren(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, story := range stories {
+			storyIDs = append(storyIDs, story.ID)
+
+			// Subtasks roll up through their story
+			tasks, err := s.repo.GetChildren(ctx, story.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, task := range tasks {
+				storyIDs = append(storyIDs, task.ID)
+			}
+		}
+	}
+	if len(storyIDs) == 0 {
+		return EffortBreakdown{}, nil
+	}
+
+	return effortRepo.SumByItems(ctx, storyIDs)
+}
+
+// GetDisciplineCapacity compares effort added with effort completed per
+// discipline over the window, surfacing skill bottlenecks
+func (s *BacklogService) GetDisciplineCapacity(ctx context.Context, effortRepo EffortRepository, windowWeeks int) (map[Discipline]*DisciplineCapacity, error) {
+	if windowWeeks <= 0 {
+		windowWeeks = 12
+	}
+
+	added, err := effortRepo.AddedPerWeek(ctx, windowWeeks)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := effortRepo.CompletedPerWeek(ctx, windowWeeks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[Discipline]*DisciplineCapacity)
+	for _, discipline := range []Discipline{DisciplineBackend, DisciplineFrontend, DisciplineQA, DisciplineDesign} {
+		result[discipline] = &DisciplineCapacity{
+			AddedPerWeek:     added[discipline],
+			CompletedPerWeek: completed[discipline],
+			NetPerWeek:       added[discipline] - completed[discipline],
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,21 @@
+-- services/backlog-service/migrations/000010_draft_items.up.sql
+
+-- Draft visibility: draft items exist only for their author until published.
+-- Everything created before this migration was already visible, so the
+-- backfill default is FALSE.
+ALTER TABLE backlog_items ADD COLUMN draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Drafts are a small minority; the partial index keeps draft lookups cheap
+-- without widening every other scan
+CREATE INDEX idx_backlog_items_draft ON backlog_items(id) WHERE draft;
+
+-- Metrics queries read through this view so drafts never skew size, lead
+-- time, or throughput numbers
+CREATE VIEW active_backlog_items AS
+    SELECT * FROM backlog_items WHERE NOT draft;
+
+-- services/backlog-service/migrations/000010_draft_items.down.sql
+
+DROP VIEW IF EXISTS active_backlog_items;
+DROP INDEX IF EXISTS idx_backlog_items_draft;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS draft;
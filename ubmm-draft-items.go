@@ -0,0 +1,76 @@
+// services/backlog-service/internal/domain/service/drafts.go
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// PublishDraft makes a draft item visible to the whole workspace. From this
+// point it appears in default lists and counts toward metrics; the publish
+// event is what downstream consumers treat as "a new item arrived".
+func (s *BacklogService) PublishDraft(ctx context.Context, id uuid.UUID) (*model.BacklogItem, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := item.Publish(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	// Store and publish the event
+	publishEvent := event.NewItemPublishedEvent(id, item)
+	if err := s.eventRepo.StoreEvent(ctx, publishEvent); err != nil {
+		s.logger.Error("Failed to store item published event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.published", publishEvent); err != nil {
+		s.logger.Error("Failed to publish item published event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.invalidateListCache(ctx)
+
+	return item, nil
+}
+
+// DiscardDraft deletes a draft item. Published items cannot be discarded;
+// they go through the normal delete path with its cascade rules.
+func (s *BacklogService) DiscardDraft(ctx context.Context, id uuid.UUID) error {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !item.Draft {
+		return errors.New("item is not a draft")
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// A discarded draft was never visible, so no deleted event is published;
+	// the stored event keeps the author's audit trail complete
+	deleteEvent := event.NewItemDeletedEvent(id, item)
+	if err := s.eventRepo.StoreEvent(ctx, deleteEvent); err != nil {
+		s.logger.Error("Failed to store item deleted event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+
+	return nil
+}
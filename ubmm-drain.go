@@ -0,0 +1,111 @@
+// services/backlog-service/internal/lifecycle/drain.go
+
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// StopFunc stops one background subsystem, finishing or handing off its
+// in-flight work before returning
+type StopFunc func(ctx context.Context) error
+
+// namedStop pairs a stop function with its subsystem name for logging
+type namedStop struct {
+	name string
+	stop StopFunc
+}
+
+// Drainer coordinates zero-downtime shutdown: it flips the gRPC health
+// status to NOT_SERVING so load balancers stop routing new work, then stops
+// registered background subsystems in reverse registration order so
+// consumers of a subsystem stop before the subsystem itself. The process
+// exits only after Drain returns and the servers finish their in-flight
+// requests.
+type Drainer struct {
+	health  *health.Server
+	timeout time.Duration
+	logger  *zap.Logger
+
+	mu    sync.Mutex
+	stops []namedStop
+
+	once sync.Once
+	done chan struct{}
+}
+
+// NewDrainer creates a new drainer. timeout bounds a drain triggered over
+// HTTP; signal-triggered drains use the caller's context instead.
+func NewDrainer(health *health.Server, timeout time.Duration, logger *zap.Logger) *Drainer {
+	return &Drainer{
+		health:  health,
+		timeout: timeout,
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+}
+
+// Register adds a subsystem to stop during drain. Register dependencies
+// before their consumers: stops run in reverse registration order.
+func (d *Drainer) Register(name string, stop StopFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stops = append(d.stops, namedStop{name: name, stop: stop})
+}
+
+// Done is closed once a drain has started, whether by signal or endpoint
+func (d *Drainer) Done() <-chan struct{} {
+	return d.done
+}
+
+// Drain runs the drain sequence exactly once; concurrent and repeated calls
+// wait for the first to finish
+func (d *Drainer) Drain(ctx context.Context) {
+	d.once.Do(func() {
+		defer close(d.done)
+
+		d.logger.Info("Draining: refusing new work")
+		d.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+		d.mu.Lock()
+		stops := make([]namedStop, len(d.stops))
+		copy(stops, d.stops)
+		d.mu.Unlock()
+
+		for i := len(stops) - 1; i >= 0; i-- {
+			d.logger.Info("Stopping subsystem", zap.String("subsystem", stops[i].name))
+			if err := stops[i].stop(ctx); err != nil {
+				d.logger.Error("Failed to stop subsystem cleanly",
+					zap.String("subsystem", stops[i].name),
+					zap.Error(err))
+			}
+		}
+	})
+	<-d.done
+}
+
+// Handler returns the HTTP handler for the drain endpoint. POST starts the
+// drain in the background and returns 202; deploy tooling then waits for the
+// process to exit.
+func (d *Drainer) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+			defer cancel()
+			d.Drain(ctx)
+		}()
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("draining"))
+	}
+}
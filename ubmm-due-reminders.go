@@ -0,0 +1,98 @@
+// services/backlog-service/internal/domain/service/due_reminders.go
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// DueReminderJob emits daily notification events for items that are overdue
+// or due within the configured window
+type DueReminderJob struct {
+	repo           repository.BacklogRepository
+	eventPublisher event.Publisher
+	reminderDays   int
+	logger         *zap.Logger
+}
+
+// NewDueReminderJob creates a new due reminder job. reminderDays is how many
+// days ahead of the due date reminders start.
+func NewDueReminderJob(repo repository.BacklogRepository, eventPublisher event.Publisher, reminderDays int, logger *zap.Logger) *DueReminderJob {
+	if reminderDays <= 0 {
+		reminderDays = 3
+	}
+	return &DueReminderJob{
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		reminderDays:   reminderDays,
+		logger:         logger,
+	}
+}
+
+// Run scans for due and overdue items and publishes reminder events. It is
+// intended to be scheduled once per day.
+func (j *DueReminderJob) Run(ctx context.Context) error {
+	now := time.Now().UTC()
+	horizon := now.AddDate(0, 0, j.reminderDays)
+
+	const pageSize = 200
+	filter := repository.BacklogFilter{
+		DueBefore: &horizon,
+		Limit:     pageSize,
+	}
+
+	reminded := 0
+	for {
+		items, _, err := j.repo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			if item.DueDate == nil || item.Status == model.ItemStatusDone {
+				continue
+			}
+
+			reminderEvent := event.NewItemDueReminderEvent(item.ID, *item.DueDate, item.IsOverdue(now))
+			if err := j.eventPublisher.Publish(ctx, "backlog.item.due_reminder", reminderEvent); err != nil {
+				j.logger.Error("Failed to publish due reminder event",
+					zap.String("itemId", item.ID.String()),
+					zap.Error(err))
+				continue
+			}
+			reminded++
+		}
+
+		filter.Offset += pageSize
+	}
+
+	j.logger.Info("Due reminder job finished", zap.Int("reminders", reminded))
+	return nil
+}
+
+// Start runs the job daily until the context is cancelled
+func (j *DueReminderJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil {
+				j.logger.Error("Due reminder job failed", zap.Error(err))
+			}
+		}
+	}
+}
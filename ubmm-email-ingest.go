@@ -0,0 +1,169 @@
+// services/backlog-service/internal/adapters/integration/email/ingest.go
+
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// InboundEmail is a normalized inbound email, produced either by the IMAP
+// poller or by an SES/SendGrid webhook
+type InboundEmail struct {
+	MessageID   string
+	From        string
+	To          string
+	Subject     string
+	TextBody    string
+	SpamScore   float64
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to an inbound email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Size        int64
+	Content     []byte
+}
+
+// AttachmentStore persists email attachments and returns a retrievable URL
+type AttachmentStore interface {
+	// Store persists an attachment and returns its URL
+	Store(ctx context.Context, messageID string, attachment Attachment) (string, error)
+}
+
+// SenderResolver maps a sender email address to a reporter identity
+type SenderResolver interface {
+	// ResolveSender returns the reporter for an email address, or empty if unknown
+	ResolveSender(ctx context.Context, address string) (string, error)
+}
+
+// Config holds the email ingestion settings
+type Config struct {
+	// SpamThreshold rejects emails with a spam score at or above this value
+	SpamThreshold float64
+
+	// MaxBodyBytes caps the accepted body size
+	MaxBodyBytes int
+
+	// MaxAttachmentBytes caps the accepted size per attachment
+	MaxAttachmentBytes int64
+}
+
+// DefaultConfig is the email ingestion config used when none is provided
+var DefaultConfig = Config{
+	SpamThreshold:      5.0,
+	MaxBodyBytes:       256 * 1024,
+	MaxAttachmentBytes: 10 * 1024 * 1024,
+}
+
+// Ingestor converts inbound emails into NEW stories with the body as
+// description and attachments preserved as links
+type Ingestor struct {
+	cfg         Config
+	backlog     *service.BacklogService
+	attachments AttachmentStore
+	senders     SenderResolver
+	logger      *zap.Logger
+}
+
+// NewIngestor creates a new email ingestor
+func NewIngestor(cfg Config, backlog *service.BacklogService, attachments AttachmentStore, senders SenderResolver, logger *zap.Logger) *Ingestor {
+	if cfg.SpamThreshold <= 0 {
+		cfg = DefaultConfig
+	}
+	return &Ingestor{
+		cfg:         cfg,
+		backlog:     backlog,
+		attachments: attachments,
+		senders:     senders,
+		logger:      logger,
+	}
+}
+
+// Ingest validates an inbound email and creates the backing story. It returns
+// the created item, or nil when the email was rejected by a guard.
+func (i *Ingestor) Ingest(ctx context.Context, email *InboundEmail) (*model.BacklogItem, error) {
+	// Spam and size guards
+	if email.SpamScore >= i.cfg.SpamThreshold {
+		i.logger.Warn("Rejected inbound email as spam",
+			zap.String("messageId", email.MessageID),
+			zap.Float64("score", email.SpamScore))
+		return nil, nil
+	}
+	if len(email.TextBody) > i.cfg.MaxBodyBytes {
+		i.logger.Warn("Rejected inbound email over body size limit",
+			zap.String("messageId", email.MessageID),
+			zap.Int("bytes", len(email.TextBody)))
+		return nil, nil
+	}
+
+	title := strings.TrimSpace(email.Subject)
+	if title == "" {
+		title = "(no subject)"
+	}
+
+	// Preserve attachments and append links to the description
+	description := email.TextBody
+	var attachmentLinks []string
+	for _, attachment := range email.Attachments {
+		if attachment.Size > i.cfg.MaxAttachmentBytes {
+			i.logger.Warn("Skipped oversized email attachment",
+				zap.String("messageId", email.MessageID),
+				zap.String("filename", attachment.Filename),
+				zap.Int64("bytes", attachment.Size))
+			continue
+		}
+		url, err := i.attachments.Store(ctx, email.MessageID, attachment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store attachment %q: %w", attachment.Filename, err)
+		}
+		attachmentLinks = append(attachmentLinks, fmt.Sprintf("- [%s](%s)", attachment.Filename, url))
+	}
+	if len(attachmentLinks) > 0 {
+		description += "\n\n## Attachments\n\n" + strings.Join(attachmentLinks, "\n")
+	}
+
+	// Map the sender to a reporter when known
+	reporter, err := i.senders.ResolveSender(ctx, email.From)
+	if err != nil {
+		i.logger.Error("Failed to resolve email sender",
+			zap.String("from", email.From),
+			zap.Error(err))
+	}
+	if reporter != "" {
+		description = fmt.Sprintf("_Reported by %s via email._\n\n%s", reporter, description)
+	} else {
+		description = fmt.Sprintf("_Reported via email from %s._\n\n%s", email.From, description)
+	}
+
+	item, err := i.backlog.CreateItem(ctx, &service.CreateItemRequest{
+		Type:        model.ItemTypeStory,
+		Title:       title,
+		Description: description,
+		Tags:        []string{"email"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create story from email: %w", err)
+	}
+
+	// Keep the message ID as an external reference for dedupe/traceability
+	if err := i.backlog.SetExternalID(ctx, item.ID, "email", email.MessageID); err != nil {
+		i.logger.Error("Failed to store email message ID",
+			zap.String("messageId", email.MessageID),
+			zap.Error(err))
+	}
+
+	i.logger.Info("Created story from inbound email",
+		zap.String("itemId", item.ID.String()),
+		zap.String("messageId", email.MessageID))
+
+	return item, nil
+}
@@ -0,0 +1,178 @@
+// services/backlog-service/internal/domain/service/engagement.go
+
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// Engagement component weights. Like the staleness score, this is a
+// transparent weighted sum: each signal saturates so a single noisy channel
+// cannot dominate the score.
+const (
+	engagementWatchersWeight = 0.35
+	engagementCommentsWeight = 0.35
+	engagementVotesWeight    = 0.30
+
+	// Saturation half-points: the count at which a signal reaches 0.5
+	engagementWatchersHalf = 3.0
+	engagementCommentsHalf = 5.0
+	engagementVotesHalf    = 10.0
+
+	// engagementCommentWindowDays bounds comment frequency to recent
+	// activity; a debate from last year is not engagement today
+	engagementCommentWindowDays = 30
+)
+
+// EngagementSignals are the raw per-item counts behind the engagement score
+type EngagementSignals struct {
+	Watchers       int `json:"watchers"`
+	RecentComments int `json:"recentComments"`
+	WeightedVotes  int `json:"weightedVotes"`
+}
+
+// EngagementRepository resolves per-item engagement counts
+type EngagementRepository interface {
+	// CountWatchers retrieves how many users watch an item
+	CountWatchers(ctx context.Context, itemID uuid.UUID) (int, error)
+
+	// CountCommentsSince retrieves how many comments an item received since
+	// the given time
+	CountCommentsSince(ctx context.Context, itemID uuid.UUID, since time.Time) (int, error)
+}
+
+// EngagementService scores how much attention an item is getting from
+// watchers, comments and votes. The score feeds prioritization and health
+// reporting: a low-priority item everyone watches is mis-ranked.
+type EngagementService struct {
+	backlog    *BacklogService
+	engagement EngagementRepository
+	votes      VoteRepository
+	logger     *zap.Logger
+}
+
+// NewEngagementService creates a new engagement service. A nil vote
+// repository leaves the vote signal at zero.
+func NewEngagementService(backlog *BacklogService, engagement EngagementRepository, votes VoteRepository, logger *zap.Logger) *EngagementService {
+	return &EngagementService{
+		backlog:    backlog,
+		engagement: engagement,
+		votes:      votes,
+		logger:     logger,
+	}
+}
+
+// GetSignals retrieves an item's raw engagement counts. Failures in one
+// channel zero that signal rather than failing the whole read.
+func (s *EngagementService) GetSignals(ctx context.Context, itemID uuid.UUID) *EngagementSignals {
+	signals := &EngagementSignals{}
+
+	watchers, err := s.engagement.CountWatchers(ctx, itemID)
+	if err != nil {
+		s.logger.Error("Failed to count watchers", zap.String("itemID", itemID.String()), zap.Error(err))
+	} else {
+		signals.Watchers = watchers
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -engagementCommentWindowDays)
+	comments, err := s.engagement.CountCommentsSince(ctx, itemID, since)
+	if err != nil {
+		s.logger.Error("Failed to count comments", zap.String("itemID", itemID.String()), zap.Error(err))
+	} else {
+		signals.RecentComments = comments
+	}
+
+	if s.votes != nil {
+		summary, err := s.votes.Summary(ctx, itemID)
+		if err != nil {
+			s.logger.Error("Failed to load vote summary", zap.String("itemID", itemID.String()), zap.Error(err))
+		} else {
+			signals.WeightedVotes = summary.WeightedVotes
+		}
+	}
+
+	return signals
+}
+
+// ComputeEngagementScore computes the engagement score in [0, 1] from the raw signals
+func ComputeEngagementScore(signals *EngagementSignals) float64 {
+	return engagementWatchersWeight*saturate(float64(signals.Watchers), engagementWatchersHalf) +
+		engagementCommentsWeight*saturate(float64(signals.RecentComments), engagementCommentsHalf) +
+		engagementVotesWeight*saturate(float64(signals.WeightedVotes), engagementVotesHalf)
+}
+
+// ScoreItem loads an item and attaches its engagement score
+func (s *EngagementService) ScoreItem(ctx context.Context, itemID uuid.UUID) (*model.BacklogItem, error) {
+	item, err := s.backlog.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.EngagementScore = ComputeEngagementScore(s.GetSignals(ctx, itemID))
+	return item, nil
+}
+
+// EngagedItem is one item in the engagement report with the signals behind
+// its score
+type EngagedItem struct {
+	Item            *model.BacklogItem `json:"item"`
+	EngagementScore float64            `json:"engagementScore"`
+	Signals         *EngagementSignals `json:"signals"`
+}
+
+// GetEngagementReport scores the open backlog and returns it sorted by
+// engagement, most-engaged first, for prioritization reviews. High
+// engagement far down the backlog is the mis-ranking to look for.
+func (s *EngagementService) GetEngagementReport(ctx context.Context, limit int) ([]*EngagedItem, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	items, _, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+		Statuses: []model.ItemStatus{
+			model.ItemStatusIntake, model.ItemStatusNew,
+			model.ItemStatusReady, model.ItemStatusInProgress, model.ItemStatusBlocked,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]*EngagedItem, 0, len(items))
+	for _, item := range items {
+		signals := s.GetSignals(ctx, item.ID)
+		score := ComputeEngagementScore(signals)
+		item.EngagementScore = score
+		report = append(report, &EngagedItem{
+			Item:            item,
+			EngagementScore: score,
+			Signals:         signals,
+		})
+	}
+
+	// Most-engaged first
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].EngagementScore > report[j].EngagementScore
+	})
+	if len(report) > limit {
+		report = report[:limit]
+	}
+
+	return report, nil
+}
+
+// saturate maps a count into [0, 1), reaching 0.5 at the half-point
+func saturate(n, half float64) float64 {
+	if n <= 0 {
+		return 0
+	}
+	return n / (n + half)
+}
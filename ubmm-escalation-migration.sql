@@ -0,0 +1,26 @@
+-- services/backlog-service/migrations/000017_escalation.up.sql
+
+-- Per-workspace escalation ladder: one row per rung, ordered by level. At
+-- after_days of age the rung's action fires; rungs fire in order as the item
+-- keeps aging.
+CREATE TABLE IF NOT EXISTS escalation_rules (
+    workspace_id UUID NOT NULL,
+    level INTEGER NOT NULL CHECK (level > 0),
+    after_days INTEGER NOT NULL CHECK (after_days > 0),
+    action TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, level)
+);
+
+-- The rung each item has reached. Kept out of backlog_items so escalation
+-- bookkeeping never touches updated_at; cleared when the item closes.
+CREATE TABLE IF NOT EXISTS item_escalations (
+    item_id UUID PRIMARY KEY REFERENCES backlog_items(id) ON DELETE CASCADE,
+    level INTEGER NOT NULL,
+    escalated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- services/backlog-service/migrations/000017_escalation.down.sql
+
+DROP TABLE IF EXISTS item_escalations;
+DROP TABLE IF EXISTS escalation_rules;
@@ -0,0 +1,270 @@
+// services/backlog-service/internal/domain/service/escalation.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// Escalation actions a ladder rung can take
+const (
+	// EscalationActionRaisePriority pulls the item up the backlog
+	EscalationActionRaisePriority = "RAISE_PRIORITY"
+	// EscalationActionNotifyEpicOwner notifies the owner of the item's epic
+	EscalationActionNotifyEpicOwner = "NOTIFY_EPIC_OWNER"
+	// EscalationActionNotifyAdmin notifies the workspace admin
+	EscalationActionNotifyAdmin = "NOTIFY_ADMIN"
+)
+
+// adminNotifyTarget is the notify target notification adapters resolve to
+// the workspace's admin
+const adminNotifyTarget = "workspace-admin"
+
+// EscalationRule is one rung of a workspace's escalation ladder: at
+// AfterDays of age the rung's action fires
+type EscalationRule struct {
+	Level     int    `json:"level"`
+	AfterDays int    `json:"afterDays"`
+	Action    string `json:"action"`
+}
+
+// EscalationLadder is a workspace's ordered escalation rules
+type EscalationLadder struct {
+	WorkspaceID uuid.UUID         `json:"workspaceId"`
+	Rules       []*EscalationRule `json:"rules"`
+}
+
+// EscalationRepository persists escalation ladders and the rung each item
+// has reached
+type EscalationRepository interface {
+	// SetEscalationLadder creates or replaces a workspace's ladder
+	SetEscalationLadder(ctx context.Context, ladder *EscalationLadder) error
+
+	// GetEscalationLadder retrieves a workspace's ladder, nil when none
+	GetEscalationLadder(ctx context.Context, workspaceID uuid.UUID) (*EscalationLadder, error)
+
+	// ListEscalationLadders retrieves every configured ladder
+	ListEscalationLadders(ctx context.Context) ([]*EscalationLadder, error)
+
+	// ListAgingItems retrieves a workspace's open items created before the
+	// cutoff
+	ListAgingItems(ctx context.Context, workspaceID uuid.UUID, createdBefore time.Time) ([]*model.BacklogItem, error)
+
+	// GetEscalationLevel retrieves the rung an item has reached, 0 when it
+	// has not been escalated
+	GetEscalationLevel(ctx context.Context, itemID uuid.UUID) (int, error)
+
+	// RecordEscalation stores the rung an item has reached
+	RecordEscalation(ctx context.Context, itemID uuid.UUID, level int, escalatedAt time.Time) error
+
+	// ClearEscalation removes an item's escalation state
+	ClearEscalation(ctx context.Context, itemID uuid.UUID) error
+}
+
+// EscalationService climbs aging items up their workspace's escalation
+// ladder: each rung fires once, in order, as the item keeps aging. The
+// notify actions only publish events; notification adapters fan them out.
+type EscalationService struct {
+	backlog    *BacklogService
+	escalation EscalationRepository
+	logger     *zap.Logger
+}
+
+// NewEscalationService creates a new escalation service
+func NewEscalationService(backlog *BacklogService, escalation EscalationRepository, logger *zap.Logger) *EscalationService {
+	return &EscalationService{
+		backlog:    backlog,
+		escalation: escalation,
+		logger:     logger,
+	}
+}
+
+// SetLadder creates or replaces a workspace's escalation ladder. Rules must
+// be numbered 1..n with strictly increasing age thresholds.
+func (s *EscalationService) SetLadder(ctx context.Context, ladder *EscalationLadder) error {
+	if len(ladder.Rules) == 0 {
+		return errors.New("ladder requires at least one rule")
+	}
+	for i, rule := range ladder.Rules {
+		if rule.Level != i+1 {
+			return errors.New("rule levels must be numbered 1..n in order")
+		}
+		if rule.AfterDays <= 0 {
+			return errors.New("rule age threshold must be positive")
+		}
+		if i > 0 && rule.AfterDays <= ladder.Rules[i-1].AfterDays {
+			return errors.New("rule age thresholds must be strictly increasing")
+		}
+		switch rule.Action {
+		case EscalationActionRaisePriority, EscalationActionNotifyEpicOwner, EscalationActionNotifyAdmin:
+		default:
+			return errors.New("unknown escalation action")
+		}
+	}
+
+	return s.escalation.SetEscalationLadder(ctx, ladder)
+}
+
+// AttachEscalationLevel populates an item's transient EscalationLevel field
+func (s *EscalationService) AttachEscalationLevel(ctx context.Context, item *model.BacklogItem) error {
+	level, err := s.escalation.GetEscalationLevel(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+	item.EscalationLevel = level
+	return nil
+}
+
+// Run climbs every workspace's aging items once. It is intended to be
+// scheduled daily.
+func (s *EscalationService) Run(ctx context.Context) error {
+	ladders, err := s.escalation.ListEscalationLadders(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ladder := range ladders {
+		if err := s.runLadder(ctx, ladder); err != nil {
+			s.logger.Error("Failed to apply escalation ladder",
+				zap.String("workspaceID", ladder.WorkspaceID.String()),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// runLadder fires any rungs one workspace's aging items have reached
+func (s *EscalationService) runLadder(ctx context.Context, ladder *EscalationLadder) error {
+	if len(ladder.Rules) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	cutoff := now.AddDate(0, 0, -ladder.Rules[0].AfterDays)
+
+	items, err := s.escalation.ListAgingItems(ctx, ladder.WorkspaceID, cutoff)
+	if err != nil {
+		return err
+	}
+
+	escalated := 0
+	for _, item := range items {
+		if item.Status == model.ItemStatusDone || item.Status == model.ItemStatusRejected {
+			continue
+		}
+
+		current, err := s.escalation.GetEscalationLevel(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+
+		// Fire every rung the item's age has passed but has not reached yet,
+		// in order, so a ladder never skips a notification step
+		ageDays := now.Sub(item.CreatedAt).Hours() / 24
+		for _, rule := range ladder.Rules {
+			if rule.Level <= current || float64(rule.AfterDays) > ageDays {
+				continue
+			}
+			if err := s.escalate(ctx, item, rule, ageDays); err != nil {
+				s.logger.Error("Failed to escalate item",
+					zap.String("itemID", item.ID.String()),
+					zap.Int("level", rule.Level),
+					zap.Error(err))
+				break
+			}
+			escalated++
+		}
+	}
+
+	s.logger.Info("Escalation ladder applied",
+		zap.String("workspaceID", ladder.WorkspaceID.String()),
+		zap.Int("escalations", escalated))
+	return nil
+}
+
+// escalate fires one rung for one item and records the escalation
+func (s *EscalationService) escalate(ctx context.Context, item *model.BacklogItem, rule *EscalationRule, ageDays float64) error {
+	notifyTarget := ""
+	switch rule.Action {
+	case EscalationActionRaisePriority:
+		// Halve the priority number to pull the item up half the distance to
+		// the top of the backlog
+		priority := item.Priority / 2
+		if _, err := s.backlog.UpdateItem(ctx, item.ID, &UpdateItemRequest{Priority: &priority}); err != nil {
+			return err
+		}
+		item.Priority = priority
+	case EscalationActionNotifyEpicOwner:
+		notifyTarget = s.epicOwner(ctx, item)
+	case EscalationActionNotifyAdmin:
+		notifyTarget = adminNotifyTarget
+	}
+
+	if err := s.escalation.RecordEscalation(ctx, item.ID, rule.Level, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	escalatedEvent := event.NewItemEscalatedEvent(item.ID, rule.Level, rule.Action, ageDays, notifyTarget)
+	if err := s.backlog.eventRepo.StoreEvent(ctx, escalatedEvent); err != nil {
+		s.logger.Error("Failed to store item escalated event", zap.Error(err))
+	}
+	if err := s.backlog.eventPublisher.Publish(ctx, "backlog.item.escalated", escalatedEvent); err != nil {
+		s.logger.Error("Failed to publish item escalated event", zap.Error(err))
+	}
+	return nil
+}
+
+// epicOwner walks up the parent chain to the item's epic and returns its
+// assignee, falling back to the reporter, then the admin target when the
+// item has no epic or the epic has no owner
+func (s *EscalationService) epicOwner(ctx context.Context, item *model.BacklogItem) string {
+	current := item
+	for current.ParentID != nil {
+		parent, err := s.backlog.repo.GetByID(ctx, *current.ParentID)
+		if err != nil {
+			s.logger.Error("Failed to walk parent chain for escalation",
+				zap.String("itemID", item.ID.String()),
+				zap.Error(err))
+			return adminNotifyTarget
+		}
+		current = parent
+		if current.Type == model.ItemTypeEpic {
+			break
+		}
+	}
+
+	if current.Type == model.ItemTypeEpic {
+		if current.Assignee != "" {
+			return current.Assignee
+		}
+		if current.Reporter != "" {
+			return current.Reporter
+		}
+	}
+	return adminNotifyTarget
+}
+
+// Start runs the escalation job daily until the context is cancelled
+func (s *EscalationService) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Run(ctx); err != nil {
+				s.logger.Error("Escalation job failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+-- services/backlog-service/migrations/000014_estimate_history.up.sql
+
+-- Estimate changes are first-class data: one row per StoryPoints change with
+-- old and new values, who made it, and the item's status at the time, so
+-- scope-churn math survives later edits to the item row
+CREATE TABLE IF NOT EXISTS estimate_history (
+    id UUID PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES backlog_items(id) ON DELETE CASCADE,
+    old_points INTEGER NOT NULL,
+    new_points INTEGER NOT NULL,
+    item_status TEXT NOT NULL,
+    changed_by TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_estimate_history_item_id ON estimate_history(item_id);
+
+-- Churn reports scan by change time
+CREATE INDEX idx_estimate_history_changed_at ON estimate_history(changed_at);
+
+-- Backfill from the event log: consecutive snapshots of the same item with
+-- different story points are one estimate change each. The actor is unknown
+-- for historical changes.
+INSERT INTO estimate_history (id, item_id, old_points, new_points, item_status, changed_by, changed_at)
+SELECT gen_random_uuid(), item_id, old_points, new_points, item_status, 'backfill', changed_at
+FROM (
+    SELECT item_id,
+           LAG((payload->'item'->>'storyPoints')::INTEGER) OVER w AS old_points,
+           (payload->'item'->>'storyPoints')::INTEGER AS new_points,
+           payload->'item'->>'status' AS item_status,
+           created_at AS changed_at
+    FROM events
+    WHERE payload->'item'->>'storyPoints' IS NOT NULL
+    WINDOW w AS (PARTITION BY item_id ORDER BY created_at)
+) changes
+WHERE old_points IS NOT NULL AND old_points <> new_points;
+
+-- services/backlog-service/migrations/000014_estimate_history.down.sql
+
+DROP TABLE IF EXISTS estimate_history;
@@ -0,0 +1,201 @@
+// services/backlog-service/internal/domain/service/estimate_history.go
+
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// defaultReEstimateThreshold is how many estimate changes an item tolerates
+// before the re-estimation report flags it
+const defaultReEstimateThreshold = 3
+
+// EstimateChange is one change to an item's story points: old and new values,
+// who made it, and the item's status at the time
+type EstimateChange struct {
+	ID         uuid.UUID        `json:"id"`
+	ItemID     uuid.UUID        `json:"itemId"`
+	OldPoints  int              `json:"oldPoints"`
+	NewPoints  int              `json:"newPoints"`
+	ItemStatus model.ItemStatus `json:"itemStatus"`
+	ChangedBy  string           `json:"changedBy"`
+	ChangedAt  time.Time        `json:"changedAt"`
+}
+
+// EstimateHistoryRepository persists estimate changes
+type EstimateHistoryRepository interface {
+	// Record stores a new estimate change
+	Record(ctx context.Context, change *EstimateChange) error
+
+	// GetByItemID retrieves all estimate changes for an item, oldest first
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*EstimateChange, error)
+
+	// ListBetween retrieves all estimate changes in a time window, oldest first
+	ListBetween(ctx context.Context, from, to time.Time) ([]*EstimateChange, error)
+}
+
+// EstimateHistoryService records every story point change and turns the
+// history into scope-churn metrics and re-estimation flags
+type EstimateHistoryService struct {
+	backlog *BacklogService
+	history EstimateHistoryRepository
+	logger  *zap.Logger
+}
+
+// NewEstimateHistoryService creates a new estimate history service
+func NewEstimateHistoryService(backlog *BacklogService, history EstimateHistoryRepository, logger *zap.Logger) *EstimateHistoryService {
+	return &EstimateHistoryService{
+		backlog: backlog,
+		history: history,
+		logger:  logger,
+	}
+}
+
+// ReEstimate changes an item's story points and records the change in the
+// estimate history. Setting the same value again is a no-op, not a change.
+func (s *EstimateHistoryService) ReEstimate(ctx context.Context, itemID uuid.UUID, points int, changedBy string) (*model.BacklogItem, error) {
+	item, err := s.backlog.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.StoryPoints == points {
+		return item, nil
+	}
+
+	oldPoints := item.StoryPoints
+	statusAtChange := item.Status
+
+	updated, err := s.backlog.UpdateItem(ctx, itemID, &UpdateItemRequest{StoryPoints: &points})
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the change
+	change := &EstimateChange{
+		ID:         uuid.New(),
+		ItemID:     itemID,
+		OldPoints:  oldPoints,
+		NewPoints:  points,
+		ItemStatus: statusAtChange,
+		ChangedBy:  changedBy,
+		ChangedAt:  time.Now().UTC(),
+	}
+	if err := s.history.Record(ctx, change); err != nil {
+		s.logger.Error("Failed to record estimate change", zap.Error(err))
+	}
+
+	return updated, nil
+}
+
+// GetEstimateHistory retrieves an item's estimate changes, oldest first
+func (s *EstimateHistoryService) GetEstimateHistory(ctx context.Context, itemID uuid.UUID) ([]*EstimateChange, error) {
+	return s.history.GetByItemID(ctx, itemID)
+}
+
+// ScopeChurnReport summarizes estimate movement in a time window. Added and
+// removed only count changes made after commitment (READY or later), which is
+// the churn retrospectives care about; early refinement is free.
+type ScopeChurnReport struct {
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	PointsAdded   int       `json:"pointsAdded"`
+	PointsRemoved int       `json:"pointsRemoved"`
+	NetChange     int       `json:"netChange"`
+	TotalChanges  int       `json:"totalChanges"`
+}
+
+// GetScopeChurn computes the scope-churn metrics for a time window
+func (s *EstimateHistoryService) GetScopeChurn(ctx context.Context, from, to time.Time) (*ScopeChurnReport, error) {
+	changes, err := s.history.ListBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ScopeChurnReport{From: from, To: to, TotalChanges: len(changes)}
+	for _, change := range changes {
+		if !isCommittedStatus(change.ItemStatus) {
+			continue
+		}
+		delta := change.NewPoints - change.OldPoints
+		if delta > 0 {
+			report.PointsAdded += delta
+		} else {
+			report.PointsRemoved -= delta
+		}
+		report.NetChange += delta
+	}
+
+	return report, nil
+}
+
+// ReEstimatedItem is one item flagged for frequent re-estimation
+type ReEstimatedItem struct {
+	Item          *model.BacklogItem `json:"item"`
+	Times         int                `json:"times"`
+	FirstPoints   int                `json:"firstPoints"`
+	CurrentPoints int                `json:"currentPoints"`
+}
+
+// GetFrequentlyReEstimated lists items re-estimated more than threshold
+// times, most-churned first. A high count means the item is not understood
+// and needs a spike or a split, not another estimate.
+func (s *EstimateHistoryService) GetFrequentlyReEstimated(ctx context.Context, threshold int) ([]*ReEstimatedItem, error) {
+	if threshold <= 0 {
+		threshold = defaultReEstimateThreshold
+	}
+
+	changes, err := s.history.ListBetween(ctx, time.Time{}, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	byItem := make(map[uuid.UUID][]*EstimateChange)
+	for _, change := range changes {
+		byItem[change.ItemID] = append(byItem[change.ItemID], change)
+	}
+
+	var flagged []*ReEstimatedItem
+	for itemID, itemChanges := range byItem {
+		if len(itemChanges) <= threshold {
+			continue
+		}
+
+		item, err := s.backlog.GetItem(ctx, itemID)
+		if err != nil {
+			// Deleted items fall out of the report
+			continue
+		}
+
+		flagged = append(flagged, &ReEstimatedItem{
+			Item:          item,
+			Times:         len(itemChanges),
+			FirstPoints:   itemChanges[0].OldPoints,
+			CurrentPoints: item.StoryPoints,
+		})
+	}
+
+	// Most-churned first, so the worst offenders lead the report
+	sort.Slice(flagged, func(i, j int) bool {
+		return flagged[i].Times > flagged[j].Times
+	})
+
+	return flagged, nil
+}
+
+// isCommittedStatus reports whether a status means the item was already
+// committed to when the estimate changed
+func isCommittedStatus(status model.ItemStatus) bool {
+	switch status {
+	case model.ItemStatusReady, model.ItemStatusInProgress, model.ItemStatusBlocked, model.ItemStatusDone:
+		return true
+	}
+	return false
+}
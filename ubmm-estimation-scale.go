@@ -0,0 +1,123 @@
+// services/backlog-service/internal/domain/model/estimation_scale.go
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// EstimationScale is the set of story point values a workspace allows.
+// Estimates are validated against the scale so velocity math stays
+// comparable across teams; zero always means "unestimated".
+type EstimationScale struct {
+	Name   string         `json:"name"`
+	Points []int          `json:"points"`           // allowed values, ascending
+	Labels map[string]int `json:"labels,omitempty"` // optional label mapping, e.g. t-shirt sizes
+}
+
+// FibonacciScale returns the default Fibonacci estimation scale
+func FibonacciScale() *EstimationScale {
+	return &EstimationScale{
+		Name:   "fibonacci",
+		Points: []int{1, 2, 3, 5, 8, 13, 21},
+	}
+}
+
+// PowersOfTwoScale returns the powers-of-two estimation scale
+func PowersOfTwoScale() *EstimationScale {
+	return &EstimationScale{
+		Name:   "powers_of_two",
+		Points: []int{1, 2, 4, 8, 16, 32},
+	}
+}
+
+// TShirtScale returns the t-shirt estimation scale with its point mapping
+func TShirtScale() *EstimationScale {
+	return &EstimationScale{
+		Name:   "tshirt",
+		Points: []int{1, 2, 3, 5, 8},
+		Labels: map[string]int{"XS": 1, "S": 2, "M": 3, "L": 5, "XL": 8},
+	}
+}
+
+// EstimationScaleForName resolves a configured scale name to its definition
+func EstimationScaleForName(name string) (*EstimationScale, error) {
+	switch name {
+	case "", "fibonacci":
+		return FibonacciScale(), nil
+	case "powers_of_two":
+		return PowersOfTwoScale(), nil
+	case "tshirt":
+		return TShirtScale(), nil
+	default:
+		return nil, fmt.Errorf("unknown estimation scale %q", name)
+	}
+}
+
+// IsValid reports whether a point value is on the scale. Zero is always
+// valid: it means the item is unestimated.
+func (s *EstimationScale) IsValid(points int) bool {
+	if points == 0 {
+		return true
+	}
+	for _, p := range s.Points {
+		if p == points {
+			return true
+		}
+	}
+	return false
+}
+
+// Nearest returns the closest valid values below and above the given points,
+// for suggestions in validation errors. Either bound is 0 when there is no
+// valid value on that side.
+func (s *EstimationScale) Nearest(points int) (int, int) {
+	below, above := 0, 0
+	for _, p := range s.Points {
+		if p <= points {
+			below = p
+		}
+		if p >= points && above == 0 {
+			above = p
+		}
+	}
+	return below, above
+}
+
+// PointsForLabel resolves a scale label (e.g. "M") to its point value
+func (s *EstimationScale) PointsForLabel(label string) (int, bool) {
+	points, ok := s.Labels[label]
+	return points, ok
+}
+
+// sortedCopy returns the scale's points in ascending order; scales built by
+// hand may list values unsorted
+func (s *EstimationScale) sortedCopy() []int {
+	points := make([]int, len(s.Points))
+	copy(points, s.Points)
+	sort.Ints(points)
+	return points
+}
+
+var (
+	scaleMu     sync.RWMutex
+	activeScale = FibonacciScale()
+)
+
+// SetEstimationScale installs the workspace's estimation scale. Call at
+// startup before serving traffic.
+func SetEstimationScale(scale *EstimationScale) {
+	scaleMu.Lock()
+	defer scaleMu.Unlock()
+	scale.Points = scale.sortedCopy()
+	activeScale = scale
+}
+
+// ActiveEstimationScale returns the estimation scale in use
+func ActiveEstimationScale() *EstimationScale {
+	scaleMu.RLock()
+	defer scaleMu.RUnlock()
+	return activeScale
+}
@@ -0,0 +1,165 @@
+// services/backlog-service/internal/domain/event/catalog.go
+
+package event
+
+import "fmt"
+
+// topicsByType maps every registered event type to the topic it is
+// published on. Publish sites keep their literal topic strings; this map is
+// the documented contract the catalog exposes, and conformance between the
+// two is what code review is for.
+var topicsByType = map[EventType]string{
+	EventTypeItemCreated:            "backlog.item.created",
+	EventTypeItemUpdated:            "backlog.item.updated",
+	EventTypeItemDeleted:            "backlog.item.deleted",
+	EventTypeItemsReordered:         "backlog.items.reordered",
+	EventTypeExternalIDSet:          "backlog.item.external_id.set",
+	EventTypeExternalIDRemoved:      "backlog.item.external_id.removed",
+	EventTypeUserMentioned:          "backlog.user.mentioned",
+	EventTypeQuotaWarning:           "backlog.workspace.quota.warning",
+	EventTypeWorkLogged:             "backlog.item.work.logged",
+	EventTypeItemCostOfDelayChanged: "backlog.item.cost_of_delay.changed",
+	EventTypeItemVoteCast:           "backlog.item.vote.cast",
+	EventTypeItemVoteRemoved:        "backlog.item.vote.removed",
+	EventTypeItemDueReminder:        "backlog.item.due_reminder",
+	EventTypeSyncConflictDetected:   "backlog.sync.conflict.detected",
+	EventTypeSyncConflictResolved:   "backlog.sync.conflict.resolved",
+	EventTypeItemSplit:              "backlog.item.split",
+	EventTypeItemsMerged:            "backlog.item.merged",
+	EventTypeApprovalRequested:      "backlog.item.approval.requested",
+	EventTypeApprovalDecided:        "backlog.item.approval.decided",
+	EventTypeBudgetOverspend:        "backlog.item.budget.overspend",
+	EventTypeSpikeTimeboxElapsed:    "backlog.item.spike.timebox_elapsed",
+	EventTypeItemStatusDerived:      "backlog.item.status.derived",
+	EventTypeItemCascadeApplied:     "backlog.item.cascade",
+	EventTypeItemBlocked:            "backlog.item.blocked",
+	EventTypeItemUnblocked:          "backlog.item.unblocked",
+	EventTypeItemReverted:           "backlog.item.reverted",
+	EventTypeItemPublished:          "backlog.item.published",
+	EventTypeTagRenamed:             "backlog.tag.renamed",
+	EventTypeTagsMerged:             "backlog.tag.merged",
+	EventTypeMonthlyUsage:           "backlog.usage.monthly",
+	EventTypeRetentionPolicyChanged: "backlog.retention.policy.changed",
+	EventTypeScopeChanged:           "backlog.sprint.scope_changed",
+	EventTypeItemExpiryWarning:      "backlog.item.expiry_warning",
+	EventTypeItemExpired:            "backlog.item.expired",
+	EventTypeItemEscalated:          "backlog.item.escalated",
+}
+
+// CatalogEntry describes one event type for external consumers: where it is
+// published, which schema version it carries, and the JSON schema of its
+// payload
+type CatalogEntry struct {
+	Type    EventType              `json:"type"`
+	Topic   string                 `json:"topic"`
+	Version int                    `json:"version"`
+	Schema  map[string]interface{} `json:"schema"`
+}
+
+// TopicFor resolves the topic an event type is published on
+func TopicFor(eventType EventType) (string, error) {
+	topic, ok := topicsByType[eventType]
+	if !ok {
+		return "", fmt.Errorf("no topic registered for event type %q", eventType)
+	}
+	return topic, nil
+}
+
+// Catalog builds the full event catalog from the prototype registry, sorted
+// by event type. Schemas are reflected from the Go structs on every call, so
+// the catalog cannot drift from the code.
+func Catalog() ([]CatalogEntry, error) {
+	entries := make([]CatalogEntry, 0, len(eventPrototypes))
+	for _, eventType := range AllEventTypes() {
+		entry, err := CatalogEntryFor(eventType)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+// CatalogEntryFor builds the catalog entry for a single event type
+func CatalogEntryFor(eventType EventType) (*CatalogEntry, error) {
+	topic, err := TopicFor(eventType)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := SchemaFor(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CatalogEntry{
+		Type:    eventType,
+		Topic:   topic,
+		Version: NewBaseEvent(eventType).Version,
+		Schema:  schema,
+	}, nil
+}
+
+// services/backlog-service/internal/adapters/web/event_catalog.go
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// EventCatalogPath is where the machine-readable event catalog is served.
+// It is deliberately unauthenticated: the catalog describes the contract,
+// not the data, and consumers poll it to validate payloads and detect
+// breaking changes.
+const EventCatalogPath = "/.well-known/ubmm-events"
+
+// eventCatalogResponse is the catalog document served to consumers
+type eventCatalogResponse struct {
+	Service string               `json:"service"`
+	Events  []event.CatalogEntry `json:"events"`
+}
+
+// EventCatalogHandler serves the event catalog. With ?type= it serves the
+// single matching entry; unknown types are a 404, not an error.
+func EventCatalogHandler(logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if typeParam := r.URL.Query().Get("type"); typeParam != "" {
+			entry, err := event.CatalogEntryFor(event.EventType(typeParam))
+			if err != nil {
+				http.Error(w, "unknown event type", http.StatusNotFound)
+				return
+			}
+			if err := json.NewEncoder(w).Encode(entry); err != nil {
+				logger.Error("Failed to encode catalog entry", zap.Error(err))
+			}
+			return
+		}
+
+		entries, err := event.Catalog()
+		if err != nil {
+			logger.Error("Failed to build event catalog", zap.Error(err))
+			http.Error(w, "failed to build event catalog", http.StatusInternalServerError)
+			return
+		}
+
+		response := eventCatalogResponse{
+			Service: "backlog-service",
+			Events:  entries,
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.Error("Failed to encode event catalog", zap.Error(err))
+		}
+	}
+}
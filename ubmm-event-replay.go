@@ -0,0 +1,189 @@
+// services/backlog-service/internal/domain/replay/replayer.go
+
+package replay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// StoredEvent represents a historical event read back from the events table
+type StoredEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      event.EventType `json:"type"`
+	ItemID    uuid.UUID       `json:"itemId"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   []byte          `json:"payload"`
+}
+
+// EventReader defines the interface for paging through historical events
+type EventReader interface {
+	// ReadEvents returns events matching the filter starting after the cursor,
+	// ordered by timestamp ascending, up to limit entries
+	ReadEvents(ctx context.Context, filter Filter, cursor uuid.UUID, limit int) ([]*StoredEvent, error)
+}
+
+// Handler processes a single replayed event. Used to feed projections directly
+// instead of going through Kafka.
+type Handler func(ctx context.Context, evt *StoredEvent) error
+
+// CheckpointStore persists replay progress so interrupted runs can resume
+type CheckpointStore interface {
+	// GetCheckpoint retrieves the last processed event ID for a replay run
+	GetCheckpoint(ctx context.Context, runID string) (uuid.UUID, error)
+
+	// SaveCheckpoint stores the last processed event ID for a replay run
+	SaveCheckpoint(ctx context.Context, runID string, eventID uuid.UUID) error
+}
+
+// Filter restricts which historical events are replayed
+type Filter struct {
+	Types  []event.EventType
+	ItemID *uuid.UUID
+	From   *time.Time
+	To     *time.Time
+}
+
+// Options configures a replay run
+type Options struct {
+	// RunID identifies the run for checkpointing; reusing an ID resumes it
+	RunID string
+
+	// BatchSize is the number of events fetched per page
+	BatchSize int
+
+	// RatePerSecond caps how many events are replayed per second (0 = unlimited)
+	RatePerSecond int
+
+	// CheckpointEvery persists progress after this many events
+	CheckpointEvery int
+}
+
+// Replayer re-publishes historical events to a target topic or hands them to
+// a projection handler, for bootstrapping new downstream consumers.
+type Replayer struct {
+	reader      EventReader
+	checkpoints CheckpointStore
+	publisher   event.Publisher
+	logger      *zap.Logger
+}
+
+// NewReplayer creates a new replayer
+func NewReplayer(reader EventReader, checkpoints CheckpointStore, publisher event.Publisher, logger *zap.Logger) *Replayer {
+	return &Replayer{
+		reader:      reader,
+		checkpoints: checkpoints,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// ReplayToTopic re-publishes matching events to the given Kafka topic
+func (r *Replayer) ReplayToTopic(ctx context.Context, topic string, filter Filter, opts Options) (int, error) {
+	return r.run(ctx, filter, opts, func(ctx context.Context, evt *StoredEvent) error {
+		return r.publisher.Publish(ctx, topic, evt)
+	})
+}
+
+// ReplayToHandler feeds matching events directly into a projection handler
+func (r *Replayer) ReplayToHandler(ctx context.Context, handler Handler, filter Filter, opts Options) (int, error) {
+	return r.run(ctx, filter, opts, handler)
+}
+
+// run drives the replay loop: page, throttle, process, checkpoint
+func (r *Replayer) run(ctx context.Context, filter Filter, opts Options, handle Handler) (int, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.CheckpointEvery <= 0 {
+		opts.CheckpointEvery = opts.BatchSize
+	}
+
+	// Resume from the last checkpoint if one exists
+	cursor := uuid.Nil
+	if opts.RunID != "" {
+		checkpoint, err := r.checkpoints.GetCheckpoint(ctx, opts.RunID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load replay checkpoint: %w", err)
+		}
+		cursor = checkpoint
+	}
+
+	// Optional rate limiter
+	var ticker *time.Ticker
+	if opts.RatePerSecond > 0 {
+		ticker = time.NewTicker(time.Second / time.Duration(opts.RatePerSecond))
+		defer ticker.Stop()
+	}
+
+	processed := 0
+	sinceCheckpoint := 0
+
+	for {
+		events, err := r.reader.ReadEvents(ctx, filter, cursor, opts.BatchSize)
+		if err != nil {
+			return processed, fmt.Errorf("failed to read events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, evt := range events {
+			// Respect cancellation between events
+			select {
+			case <-ctx.Done():
+				return processed, ctx.Err()
+			default:
+			}
+
+			// Throttle if a rate cap is configured
+			if ticker != nil {
+				select {
+				case <-ctx.Done():
+					return processed, ctx.Err()
+				case <-ticker.C:
+				}
+			}
+
+			if err := handle(ctx, evt); err != nil {
+				return processed, fmt.Errorf("failed to replay event %s: %w", evt.ID, err)
+			}
+
+			cursor = evt.ID
+			processed++
+			sinceCheckpoint++
+
+			// Persist progress periodically
+			if opts.RunID != "" && sinceCheckpoint >= opts.CheckpointEvery {
+				if err := r.checkpoints.SaveCheckpoint(ctx, opts.RunID, cursor); err != nil {
+					r.logger.Error("Failed to save replay checkpoint",
+						zap.String("runId", opts.RunID),
+						zap.Error(err))
+				} else {
+					sinceCheckpoint = 0
+				}
+			}
+		}
+	}
+
+	// Final checkpoint
+	if opts.RunID != "" && sinceCheckpoint > 0 {
+		if err := r.checkpoints.SaveCheckpoint(ctx, opts.RunID, cursor); err != nil {
+			r.logger.Error("Failed to save final replay checkpoint",
+				zap.String("runId", opts.RunID),
+				zap.Error(err))
+		}
+	}
+
+	r.logger.Info("Replay completed",
+		zap.String("runId", opts.RunID),
+		zap.Int("events", processed))
+
+	return processed, nil
+}
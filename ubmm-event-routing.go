@@ -0,0 +1,231 @@
+// services/backlog-service/internal/domain/event/routing.go
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// Payload trim modes for routing destinations
+const (
+	// TrimFull forwards the event payload unchanged
+	TrimFull = "full"
+	// TrimIDsOnly strips embedded item bodies, leaving type, IDs and
+	// timestamps; consumers fetch the item themselves when they need it
+	TrimIDsOnly = "ids_only"
+)
+
+// RoutingRule decides which destinations receive which events. Empty
+// EventTypes or WorkspaceIDs match everything; Trim defaults to full.
+type RoutingRule struct {
+	// EventTypes lists the event type strings the rule matches, e.g.
+	// "ITEM_CREATED"; empty matches all types
+	EventTypes []string `json:"eventTypes"`
+
+	// WorkspaceIDs restricts the rule to events carrying one of these
+	// workspace IDs; empty matches all workspaces
+	WorkspaceIDs []string `json:"workspaceIds"`
+
+	// Topics lists extra Kafka topics the event is copied to
+	Topics []string `json:"topics"`
+
+	// WebhookURLs lists HTTP endpoints the event is POSTed to
+	WebhookURLs []string `json:"webhookUrls"`
+
+	// Trim is the payload trim mode for this rule's destinations
+	Trim string `json:"trim"`
+}
+
+// WebhookSender delivers an event payload to an HTTP endpoint
+type WebhookSender interface {
+	// SendWebhook POSTs the payload to the URL
+	SendWebhook(ctx context.Context, url string, payload []byte) error
+}
+
+// RoutingPublisher wraps a Publisher and fans matching events out to the
+// extra topics and webhooks its rules name. The original topic always
+// receives the full event first; routing failures are logged, never allowed
+// to fail the triggering write.
+type RoutingPublisher struct {
+	inner  Publisher
+	sender WebhookSender
+	rules  []RoutingRule
+	logger *zap.Logger
+}
+
+// NewRoutingPublisher creates a new routing publisher. sender may be nil
+// when no rule names a webhook.
+func NewRoutingPublisher(inner Publisher, sender WebhookSender, rules []RoutingRule, logger *zap.Logger) *RoutingPublisher {
+	return &RoutingPublisher{
+		inner:  inner,
+		sender: sender,
+		rules:  rules,
+		logger: logger,
+	}
+}
+
+// LoadRoutingRules reads routing rules from a JSON file holding an array of
+// RoutingRule objects
+func LoadRoutingRules(path string) ([]RoutingRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routing rules file: %w", err)
+	}
+	var rules []RoutingRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse routing rules file: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Trim != "" && rule.Trim != TrimFull && rule.Trim != TrimIDsOnly {
+			return nil, fmt.Errorf("unknown trim mode %q in routing rules", rule.Trim)
+		}
+	}
+	return rules, nil
+}
+
+// Publish delivers the event to its original topic, then to every extra
+// destination named by a matching rule
+func (p *RoutingPublisher) Publish(ctx context.Context, topic string, event interface{}) error {
+	if err := p.inner.Publish(ctx, topic, event); err != nil {
+		return err
+	}
+	if len(p.rules) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error("Failed to marshal event for routing", zap.Error(err))
+		return nil
+	}
+	eventType, workspaceID := routingKeys(payload)
+
+	for _, rule := range p.rules {
+		if !rule.matches(eventType, workspaceID) {
+			continue
+		}
+		routed := payload
+		if rule.Trim == TrimIDsOnly {
+			routed = trimIDsOnly(payload)
+		}
+
+		for _, extra := range rule.Topics {
+			if extra == topic {
+				continue
+			}
+			if err := p.inner.Publish(ctx, extra, json.RawMessage(routed)); err != nil {
+				p.logger.Error("Failed to route event to topic",
+					zap.String("topic", extra),
+					zap.Error(err))
+			}
+		}
+		for _, url := range rule.WebhookURLs {
+			if p.sender == nil {
+				p.logger.Warn("Routing rule names a webhook but no sender is configured",
+					zap.String("url", url))
+				continue
+			}
+			if err := p.sender.SendWebhook(ctx, url, routed); err != nil {
+				p.logger.Error("Failed to route event to webhook",
+					zap.String("url", url),
+					zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// matches reports whether the rule applies to an event's type and workspace
+func (r *RoutingRule) matches(eventType, workspaceID string) bool {
+	if len(r.EventTypes) > 0 && !contains(r.EventTypes, eventType) {
+		return false
+	}
+	if len(r.WorkspaceIDs) > 0 && !contains(r.WorkspaceIDs, workspaceID) {
+		return false
+	}
+	return true
+}
+
+// routingKeys extracts the type and workspace ID fields routing matches on
+func routingKeys(payload []byte) (eventType, workspaceID string) {
+	var keys struct {
+		Type        string `json:"type"`
+		WorkspaceID string `json:"workspaceId"`
+	}
+	_ = json.Unmarshal(payload, &keys)
+	return keys.Type, keys.WorkspaceID
+}
+
+// trimIDsOnly removes embedded item bodies from an event payload, keeping
+// the scalar fields. On any parse problem the full payload is returned.
+func trimIDsOnly(payload []byte) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return payload
+	}
+	delete(fields, "item")
+	delete(fields, "items")
+	trimmed, err := json.Marshal(fields)
+	if err != nil {
+		return payload
+	}
+	return trimmed
+}
+
+// contains reports whether values holds value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// services/backlog-service/internal/adapters/integration/httpclient/webhook_sender.go
+
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WebhookSender delivers routed event payloads over the shared outbound
+// client, inheriting its rate limiting, retries and circuit breaking
+type WebhookSender struct {
+	client *Client
+}
+
+// NewWebhookSender creates a new webhook sender
+func NewWebhookSender(client *Client) *WebhookSender {
+	return &WebhookSender{client: client}
+}
+
+// SendWebhook POSTs the payload to the URL as JSON
+func (s *WebhookSender) SendWebhook(ctx context.Context, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-UBMM-Source", "backlog-service")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+// services/backlog-service/internal/domain/event/schema.go
+
+package event
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// eventPrototypes maps every EventType to the Go struct carrying its
+// payload. This is the single registry the schema generator and the event
+// catalog reflect over; a new event type is not published until it is
+// listed here.
+var eventPrototypes = map[EventType]interface{}{
+	EventTypeItemCreated:            ItemCreatedEvent{},
+	EventTypeItemUpdated:            ItemUpdatedEvent{},
+	EventTypeItemDeleted:            ItemDeletedEvent{},
+	EventTypeItemsReordered:         ItemsReorderedEvent{},
+	EventTypeExternalIDSet:          ExternalIDSetEvent{},
+	EventTypeExternalIDRemoved:      ExternalIDRemovedEvent{},
+	EventTypeUserMentioned:          UserMentionedEvent{},
+	EventTypeQuotaWarning:           QuotaWarningEvent{},
+	EventTypeWorkLogged:             WorkLoggedEvent{},
+	EventTypeItemCostOfDelayChanged: ItemCostOfDelayChangedEvent{},
+	EventTypeItemVoteCast:           ItemVoteCastEvent{},
+	EventTypeItemVoteRemoved:        ItemVoteRemovedEvent{},
+	EventTypeItemDueReminder:        ItemDueReminderEvent{},
+	EventTypeSyncConflictDetected:   SyncConflictDetectedEvent{},
+	EventTypeSyncConflictResolved:   SyncConflictResolvedEvent{},
+	EventTypeItemSplit:              ItemSplitEvent{},
+	EventTypeItemsMerged:            ItemsMergedEvent{},
+	EventTypeApprovalRequested:      ApprovalRequestedEvent{},
+	EventTypeApprovalDecided:        ApprovalDecidedEvent{},
+	EventTypeBudgetOverspend:        BudgetOverspendEvent{},
+	EventTypeSpikeTimeboxElapsed:    SpikeTimeboxElapsedEvent{},
+	EventTypeItemStatusDerived:      ItemStatusDerivedEvent{},
+	EventTypeItemCascadeApplied:     ItemCascadeAppliedEvent{},
+	EventTypeItemBlocked:            ItemBlockedEvent{},
+	EventTypeItemUnblocked:          ItemUnblockedEvent{},
+	EventTypeItemReverted:           ItemRevertedEvent{},
+	EventTypeItemPublished:          ItemPublishedEvent{},
+	EventTypeTagRenamed:             TagRenamedEvent{},
+	EventTypeTagsMerged:             TagsMergedEvent{},
+	EventTypeMonthlyUsage:           MonthlyUsageEvent{},
+	EventTypeRetentionPolicyChanged: RetentionPolicyChangedEvent{},
+	EventTypeScopeChanged:           ScopeChangedEvent{},
+	EventTypeItemExpiryWarning:      ItemExpiryWarningEvent{},
+	EventTypeItemExpired:            ItemExpiredEvent{},
+	EventTypeItemEscalated:          ItemEscalatedEvent{},
+}
+
+// AllEventTypes returns every registered event type, sorted for stable
+// output
+func AllEventTypes() []EventType {
+	types := make([]EventType, 0, len(eventPrototypes))
+	for eventType := range eventPrototypes {
+		types = append(types, eventType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// SchemaFor builds the JSON schema for an event type's payload by
+// reflecting over its Go struct, so the schema cannot drift from the code
+func SchemaFor(eventType EventType) (map[string]interface{}, error) {
+	prototype, ok := eventPrototypes[eventType]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+
+	schema := schemaOf(reflect.TypeOf(prototype))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = string(eventType)
+	return schema, nil
+}
+
+var (
+	uuidType = reflect.TypeOf(uuid.UUID{})
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// schemaOf maps one Go type onto its JSON schema fragment
+func schemaOf(t reflect.Type) map[string]interface{} {
+	switch t {
+	case uuidType:
+		return map[string]interface{}{"type": "string", "format": "uuid"}
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaOf(t.Elem())
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		// interface{} and anything else: any JSON value
+		return map[string]interface{}{}
+	}
+}
+
+// structSchema maps a struct onto a JSON object schema. Embedded structs are
+// flattened the way encoding/json flattens them; fields without omitempty
+// and without a pointer type are required.
+func structSchema(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []string
+
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+				walk(field.Type)
+				continue
+			}
+
+			name, omitempty := jsonName(field)
+			if name == "-" {
+				continue
+			}
+
+			properties[name] = schemaOf(field.Type)
+			if !omitempty && field.Type.Kind() != reflect.Ptr {
+				required = append(required, name)
+			}
+		}
+	}
+	walk(t)
+
+	sort.Strings(required)
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// jsonName resolves a field's JSON name and omitempty flag from its tag
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name, false
+	}
+
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	omitempty := false
+	for _, part := range parts[1:] {
+		if part == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+// services/backlog-service/cmd/eventschema/main.go
+
+// eventschema emits one JSON schema file per registered event type, for the
+// client codegen pipeline (scripts/generate-clients.sh) to turn into typed
+// event models for each client ecosystem.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+func main() {
+	out := flag.String("out", "gen/schemas", "directory to write schema files into")
+	flag.Parse()
+
+	if err := run(*out); err != nil {
+		fmt.Fprintf(os.Stderr, "eventschema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out string) error {
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	for _, eventType := range event.AllEventTypes() {
+		schema, err := event.SchemaFor(eventType)
+		if err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal schema for %s: %w", eventType, err)
+		}
+
+		path := filepath.Join(out, string(eventType)+".json")
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
@@ -24,6 +24,74 @@ const (
 	EventTypeItemsReordered EventType = "ITEMS_REORDERED"
 	// EventTypeExternalIDSet represents an external ID set event
 	EventTypeExternalIDSet EventType = "EXTERNAL_ID_SET"
+
+	// EventTypeExternalIDRemoved represents an external ID removed event
+	EventTypeExternalIDRemoved EventType = "EXTERNAL_ID_REMOVED"
+	// EventTypeUserMentioned represents a user mentioned in an item description
+	EventTypeUserMentioned EventType = "USER_MENTIONED"
+	// EventTypeQuotaWarning represents a workspace quota threshold warning
+	EventTypeQuotaWarning EventType = "QUOTA_WARNING"
+	// EventTypeWorkLogged represents time logged against an item
+	EventTypeWorkLogged EventType = "WORK_LOGGED"
+	// EventTypeItemCostOfDelayChanged represents a cost of delay change
+	EventTypeItemCostOfDelayChanged EventType = "ITEM_COST_OF_DELAY_CHANGED"
+	// EventTypeItemVoteCast represents a vote cast on an item
+	EventTypeItemVoteCast EventType = "ITEM_VOTE_CAST"
+	// EventTypeItemVoteRemoved represents a vote removed from an item
+	EventTypeItemVoteRemoved EventType = "ITEM_VOTE_REMOVED"
+	// EventTypeItemDueReminder represents a due date reminder for an item
+	EventTypeItemDueReminder EventType = "ITEM_DUE_REMINDER"
+	// EventTypeSyncConflictDetected represents a detected external sync conflict
+	EventTypeSyncConflictDetected EventType = "SYNC_CONFLICT_DETECTED"
+	// EventTypeSyncConflictResolved represents a resolved external sync conflict
+	EventTypeSyncConflictResolved EventType = "SYNC_CONFLICT_RESOLVED"
+	// EventTypeItemSplit represents an item split into multiple new items
+	EventTypeItemSplit EventType = "ITEM_SPLIT"
+	// EventTypeItemsMerged represents duplicate items merged into a survivor
+	EventTypeItemsMerged EventType = "ITEMS_MERGED"
+	// EventTypeApprovalRequested represents an item entering the approval gate
+	EventTypeApprovalRequested EventType = "APPROVAL_REQUESTED"
+	// EventTypeApprovalDecided represents an approval being granted or rejected
+	EventTypeApprovalDecided EventType = "APPROVAL_DECIDED"
+	// EventTypeBudgetOverspend represents an epic's actual cost exceeding its budget
+	EventTypeBudgetOverspend EventType = "BUDGET_OVERSPEND"
+	// EventTypeSpikeTimeboxElapsed represents a spike exceeding its timebox
+	EventTypeSpikeTimeboxElapsed EventType = "SPIKE_TIMEBOX_ELAPSED"
+	// EventTypeItemStatusDerived represents a parent status change derived
+	// from its children rather than set directly
+	EventTypeItemStatusDerived EventType = "ITEM_STATUS_DERIVED"
+	// EventTypeItemCascadeApplied represents a cascade (delete, close or
+	// re-parent) applied to an item and its descendants
+	EventTypeItemCascadeApplied EventType = "ITEM_CASCADE_APPLIED"
+	// EventTypeItemBlocked represents an item being blocked with a reason
+	EventTypeItemBlocked EventType = "ITEM_BLOCKED"
+	// EventTypeItemUnblocked represents an item leaving the blocked state
+	EventTypeItemUnblocked EventType = "ITEM_UNBLOCKED"
+	// EventTypeItemReverted represents an item reverted to an earlier
+	// recorded state
+	EventTypeItemReverted EventType = "ITEM_REVERTED"
+	// EventTypeItemPublished represents a draft item becoming visible to the
+	// whole workspace
+	EventTypeItemPublished EventType = "ITEM_PUBLISHED"
+	// EventTypeTagRenamed represents a tag renamed across the backlog
+	EventTypeTagRenamed EventType = "TAG_RENAMED"
+	// EventTypeTagsMerged represents several tags merged into one
+	EventTypeTagsMerged EventType = "TAGS_MERGED"
+	// EventTypeMonthlyUsage represents a workspace's monthly usage rollup
+	EventTypeMonthlyUsage EventType = "MONTHLY_USAGE_REPORTED"
+	// EventTypeRetentionPolicyChanged represents a workspace retention
+	// policy being created or changed
+	EventTypeRetentionPolicyChanged EventType = "RETENTION_POLICY_CHANGED"
+	// EventTypeScopeChanged represents an active sprint's scope changing
+	EventTypeScopeChanged EventType = "SCOPE_CHANGED"
+	// EventTypeItemExpiryWarning represents a cold item entering its
+	// expiry grace period
+	EventTypeItemExpiryWarning EventType = "ITEM_EXPIRY_WARNING"
+	// EventTypeItemExpired represents a cold item expired by policy
+	EventTypeItemExpired EventType = "ITEM_EXPIRED"
+	// EventTypeItemEscalated represents an aging item reaching a rung of the
+	// escalation ladder
+	EventTypeItemEscalated EventType = "ITEM_ESCALATED"
 )
 
 // Event defines the base event structure
@@ -69,6 +137,23 @@ type ExternalIDSetEvent struct {
 	ExternalID string    `json:"externalId"`
 }
 
+// ExternalIDRemovedEvent represents an event when an external ID mapping is
+// removed from an item
+type ExternalIDRemovedEvent struct {
+	Event
+	ItemID     uuid.UUID `json:"itemId"`
+	System     string    `json:"system"`
+	ExternalID string    `json:"externalId"`
+}
+
+// UserMentionedEvent represents an event when a user is @mentioned in an item
+// description; downstream consumers use it to trigger notifications
+type UserMentionedEvent struct {
+	Event
+	ItemID uuid.UUID `json:"itemId"`
+	UserID string    `json:"userId"`
+}
+
 // NewBaseEvent creates a new base event
 func NewBaseEvent(eventType EventType) Event {
 	return Event{
@@ -114,6 +199,446 @@ func NewItemsReorderedEvent(itemPriorities map[uuid.UUID]int) *ItemsReorderedEve
 	}
 }
 
+// QuotaWarningEvent represents an event when a workspace's quota usage
+// crosses the warning threshold
+type QuotaWarningEvent struct {
+	Event
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	Quota       string    `json:"quota"`
+	Used        int64     `json:"used"`
+	Limit       int64     `json:"limit"`
+}
+
+// NewQuotaWarningEvent creates a new quota warning event
+func NewQuotaWarningEvent(workspaceID uuid.UUID, quota string, used, limit int64) *QuotaWarningEvent {
+	return &QuotaWarningEvent{
+		Event:       NewBaseEvent(EventTypeQuotaWarning),
+		WorkspaceID: workspaceID,
+		Quota:       quota,
+		Used:        used,
+		Limit:       limit,
+	}
+}
+
+// WorkLoggedEvent represents an event when time is logged against an item,
+// consumed by downstream billing systems
+type WorkLoggedEvent struct {
+	Event
+	ItemID   uuid.UUID     `json:"itemId"`
+	UserID   string        `json:"userId"`
+	Duration time.Duration `json:"duration"`
+}
+
+// NewWorkLoggedEvent creates a new work logged event
+func NewWorkLoggedEvent(itemID uuid.UUID, userID string, duration time.Duration) *WorkLoggedEvent {
+	return &WorkLoggedEvent{
+		Event:    NewBaseEvent(EventTypeWorkLogged),
+		ItemID:   itemID,
+		UserID:   userID,
+		Duration: duration,
+	}
+}
+
+// ItemCostOfDelayChangedEvent represents an event when an item's cost of
+// delay or duration estimate changes, preserving the previous values for
+// economic prioritization audits
+type ItemCostOfDelayChangedEvent struct {
+	Event
+	ItemID               uuid.UUID `json:"itemId"`
+	PreviousCostPerWeek  float64   `json:"previousCostPerWeek"`
+	CostPerWeek          float64   `json:"costPerWeek"`
+	PreviousDurationWeeks float64  `json:"previousDurationWeeks"`
+	DurationWeeks        float64   `json:"durationWeeks"`
+	CD3                  float64   `json:"cd3"`
+}
+
+// NewItemCostOfDelayChangedEvent creates a new cost of delay changed event
+func NewItemCostOfDelayChangedEvent(itemID uuid.UUID, prevCost, cost, prevDuration, duration, cd3 float64) *ItemCostOfDelayChangedEvent {
+	return &ItemCostOfDelayChangedEvent{
+		Event:                NewBaseEvent(EventTypeItemCostOfDelayChanged),
+		ItemID:               itemID,
+		PreviousCostPerWeek:  prevCost,
+		CostPerWeek:          cost,
+		PreviousDurationWeeks: prevDuration,
+		DurationWeeks:        duration,
+		CD3:                  cd3,
+	}
+}
+
+// ItemVoteCastEvent represents an event when a user votes on an item
+type ItemVoteCastEvent struct {
+	Event
+	ItemID uuid.UUID `json:"itemId"`
+	UserID string    `json:"userId"`
+	Weight int       `json:"weight"`
+}
+
+// ItemVoteRemovedEvent represents an event when a user removes their vote
+type ItemVoteRemovedEvent struct {
+	Event
+	ItemID uuid.UUID `json:"itemId"`
+	UserID string    `json:"userId"`
+}
+
+// NewItemVoteCastEvent creates a new item vote cast event
+func NewItemVoteCastEvent(itemID uuid.UUID, userID string, weight int) *ItemVoteCastEvent {
+	return &ItemVoteCastEvent{
+		Event:  NewBaseEvent(EventTypeItemVoteCast),
+		ItemID: itemID,
+		UserID: userID,
+		Weight: weight,
+	}
+}
+
+// NewItemVoteRemovedEvent creates a new item vote removed event
+func NewItemVoteRemovedEvent(itemID uuid.UUID, userID string) *ItemVoteRemovedEvent {
+	return &ItemVoteRemovedEvent{
+		Event:  NewBaseEvent(EventTypeItemVoteRemoved),
+		ItemID: itemID,
+		UserID: userID,
+	}
+}
+
+// ItemDueReminderEvent represents a reminder that an item is overdue or
+// approaching its due date; downstream consumers turn it into notifications
+type ItemDueReminderEvent struct {
+	Event
+	ItemID  uuid.UUID `json:"itemId"`
+	DueDate time.Time `json:"dueDate"`
+	Overdue bool      `json:"overdue"`
+}
+
+// NewItemDueReminderEvent creates a new item due reminder event
+func NewItemDueReminderEvent(itemID uuid.UUID, dueDate time.Time, overdue bool) *ItemDueReminderEvent {
+	return &ItemDueReminderEvent{
+		Event:   NewBaseEvent(EventTypeItemDueReminder),
+		ItemID:  itemID,
+		DueDate: dueDate,
+		Overdue: overdue,
+	}
+}
+
+// SyncConflictDetectedEvent represents an event when an external sync detects
+// a conflicting field edit that requires manual resolution
+type SyncConflictDetectedEvent struct {
+	Event
+	ItemID uuid.UUID `json:"itemId"`
+	System string    `json:"system"`
+	Field  string    `json:"field"`
+}
+
+// SyncConflictResolvedEvent represents an event when a queued sync conflict
+// is resolved
+type SyncConflictResolvedEvent struct {
+	Event
+	ItemID     uuid.UUID `json:"itemId"`
+	System     string    `json:"system"`
+	Field      string    `json:"field"`
+	Resolution string    `json:"resolution"`
+}
+
+// NewSyncConflictDetectedEvent creates a new sync conflict detected event
+func NewSyncConflictDetectedEvent(itemID uuid.UUID, system, field string) *SyncConflictDetectedEvent {
+	return &SyncConflictDetectedEvent{
+		Event:  NewBaseEvent(EventTypeSyncConflictDetected),
+		ItemID: itemID,
+		System: system,
+		Field:  field,
+	}
+}
+
+// NewSyncConflictResolvedEvent creates a new sync conflict resolved event
+func NewSyncConflictResolvedEvent(itemID uuid.UUID, system, field, resolution string) *SyncConflictResolvedEvent {
+	return &SyncConflictResolvedEvent{
+		Event:      NewBaseEvent(EventTypeSyncConflictResolved),
+		ItemID:     itemID,
+		System:     system,
+		Field:      field,
+		Resolution: resolution,
+	}
+}
+
+// ItemSplitEvent represents an event when an item is split into smaller items
+type ItemSplitEvent struct {
+	Event
+	SourceItemID   uuid.UUID   `json:"sourceItemId"`
+	NewItemIDs     []uuid.UUID `json:"newItemIds"`
+	OriginalClosed bool        `json:"originalClosed"`
+}
+
+// NewItemSplitEvent creates a new item split event
+func NewItemSplitEvent(sourceItemID uuid.UUID, newItemIDs []uuid.UUID, originalClosed bool) *ItemSplitEvent {
+	return &ItemSplitEvent{
+		Event:          NewBaseEvent(EventTypeItemSplit),
+		SourceItemID:   sourceItemID,
+		NewItemIDs:     newItemIDs,
+		OriginalClosed: originalClosed,
+	}
+}
+
+// ItemsMergedEvent represents an event when duplicate items are merged into
+// a surviving item
+type ItemsMergedEvent struct {
+	Event
+	SurvivorID   uuid.UUID   `json:"survivorId"`
+	DuplicateIDs []uuid.UUID `json:"duplicateIds"`
+}
+
+// NewItemsMergedEvent creates a new items merged event
+func NewItemsMergedEvent(survivorID uuid.UUID, duplicateIDs []uuid.UUID) *ItemsMergedEvent {
+	return &ItemsMergedEvent{
+		Event:        NewBaseEvent(EventTypeItemsMerged),
+		SurvivorID:   survivorID,
+		DuplicateIDs: duplicateIDs,
+	}
+}
+
+// ApprovalRequestedEvent represents an event when an item crosses the
+// approval threshold and enters the approval gate
+type ApprovalRequestedEvent struct {
+	Event
+	ItemID      uuid.UUID `json:"itemId"`
+	RequestedBy string    `json:"requestedBy"`
+	StoryPoints int       `json:"storyPoints"`
+}
+
+// ApprovalDecidedEvent represents an event when a pending approval is
+// granted or rejected; part of the approval audit trail
+type ApprovalDecidedEvent struct {
+	Event
+	ItemID    uuid.UUID `json:"itemId"`
+	DecidedBy string    `json:"decidedBy"`
+	Approved  bool      `json:"approved"`
+	Comment   string    `json:"comment"`
+}
+
+// NewApprovalRequestedEvent creates a new approval requested event
+func NewApprovalRequestedEvent(itemID uuid.UUID, requestedBy string, storyPoints int) *ApprovalRequestedEvent {
+	return &ApprovalRequestedEvent{
+		Event:       NewBaseEvent(EventTypeApprovalRequested),
+		ItemID:      itemID,
+		RequestedBy: requestedBy,
+		StoryPoints: storyPoints,
+	}
+}
+
+// NewApprovalDecidedEvent creates a new approval decided event
+func NewApprovalDecidedEvent(itemID uuid.UUID, decidedBy string, approved bool, comment string) *ApprovalDecidedEvent {
+	return &ApprovalDecidedEvent{
+		Event:     NewBaseEvent(EventTypeApprovalDecided),
+		ItemID:    itemID,
+		DecidedBy: decidedBy,
+		Approved:  approved,
+		Comment:   comment,
+	}
+}
+
+// BudgetOverspendEvent represents an event when an epic's actual cost
+// exceeds its budget
+type BudgetOverspendEvent struct {
+	Event
+	ItemID     uuid.UUID `json:"itemId"`
+	Budget     float64   `json:"budget"`
+	ActualCost float64   `json:"actualCost"`
+}
+
+// NewBudgetOverspendEvent creates a new budget overspend event
+func NewBudgetOverspendEvent(itemID uuid.UUID, budget, actualCost float64) *BudgetOverspendEvent {
+	return &BudgetOverspendEvent{
+		Event:      NewBaseEvent(EventTypeBudgetOverspend),
+		ItemID:     itemID,
+		Budget:     budget,
+		ActualCost: actualCost,
+	}
+}
+
+// SpikeTimeboxElapsedEvent represents an event when a spike runs past its
+// timebox without being closed
+type SpikeTimeboxElapsedEvent struct {
+	Event
+	ItemID       uuid.UUID `json:"itemId"`
+	TimeboxHours float64   `json:"timeboxHours"`
+	ElapsedHours float64   `json:"elapsedHours"`
+}
+
+// NewSpikeTimeboxElapsedEvent creates a new spike timebox elapsed event
+func NewSpikeTimeboxElapsedEvent(itemID uuid.UUID, timeboxHours, elapsedHours float64) *SpikeTimeboxElapsedEvent {
+	return &SpikeTimeboxElapsedEvent{
+		Event:        NewBaseEvent(EventTypeSpikeTimeboxElapsed),
+		ItemID:       itemID,
+		TimeboxHours: timeboxHours,
+		ElapsedHours: elapsedHours,
+	}
+}
+
+// ItemStatusDerivedEvent represents an event when a parent's status is
+// derived from its children's statuses
+type ItemStatusDerivedEvent struct {
+	Event
+	ItemID      uuid.UUID        `json:"itemId"`
+	OldStatus   model.ItemStatus `json:"oldStatus"`
+	NewStatus   model.ItemStatus `json:"newStatus"`
+	TriggeredBy uuid.UUID        `json:"triggeredBy"` // the child whose change drove the derivation
+}
+
+// NewItemStatusDerivedEvent creates a new item status derived event
+func NewItemStatusDerivedEvent(itemID uuid.UUID, oldStatus, newStatus model.ItemStatus, triggeredBy uuid.UUID) *ItemStatusDerivedEvent {
+	return &ItemStatusDerivedEvent{
+		Event:       NewBaseEvent(EventTypeItemStatusDerived),
+		ItemID:      itemID,
+		OldStatus:   oldStatus,
+		NewStatus:   newStatus,
+		TriggeredBy: triggeredBy,
+	}
+}
+
+// ItemCascadeAppliedEvent represents an event when a cascade operation is
+// applied to an item and its descendants. One event covers the whole
+// cascade; consumers should not expect per-item events for the descendants.
+type ItemCascadeAppliedEvent struct {
+	Event
+	ItemID      uuid.UUID   `json:"itemId"`
+	Operation   string      `json:"operation"` // "DELETE" or "CLOSE"
+	Mode        string      `json:"mode"`
+	AffectedIDs []uuid.UUID `json:"affectedIds"`
+}
+
+// NewItemCascadeAppliedEvent creates a new item cascade applied event
+func NewItemCascadeAppliedEvent(itemID uuid.UUID, operation, mode string, affectedIDs []uuid.UUID) *ItemCascadeAppliedEvent {
+	return &ItemCascadeAppliedEvent{
+		Event:       NewBaseEvent(EventTypeItemCascadeApplied),
+		ItemID:      itemID,
+		Operation:   operation,
+		Mode:        mode,
+		AffectedIDs: affectedIDs,
+	}
+}
+
+// ItemBlockedEvent represents an event when an item is blocked
+type ItemBlockedEvent struct {
+	Event
+	ItemID      uuid.UUID  `json:"itemId"`
+	Reason      string     `json:"reason"`
+	BlockedByID *uuid.UUID `json:"blockedById,omitempty"`
+}
+
+// NewItemBlockedEvent creates a new item blocked event
+func NewItemBlockedEvent(itemID uuid.UUID, reason string, blockedByID *uuid.UUID) *ItemBlockedEvent {
+	return &ItemBlockedEvent{
+		Event:       NewBaseEvent(EventTypeItemBlocked),
+		ItemID:      itemID,
+		Reason:      reason,
+		BlockedByID: blockedByID,
+	}
+}
+
+// ItemUnblockedEvent represents an event when an item leaves the blocked state
+type ItemUnblockedEvent struct {
+	Event
+	ItemID       uuid.UUID `json:"itemId"`
+	BlockedHours float64   `json:"blockedHours"`
+}
+
+// NewItemUnblockedEvent creates a new item unblocked event
+func NewItemUnblockedEvent(itemID uuid.UUID, blockedHours float64) *ItemUnblockedEvent {
+	return &ItemUnblockedEvent{
+		Event:        NewBaseEvent(EventTypeItemUnblocked),
+		ItemID:       itemID,
+		BlockedHours: blockedHours,
+	}
+}
+
+// ItemRevertedEvent represents an event when an item is reverted to an
+// earlier recorded state. RevertedTo is the point in history the state was
+// taken from; Item is the state after the revert.
+type ItemRevertedEvent struct {
+	Event
+	ItemID     uuid.UUID          `json:"itemId"`
+	RevertedTo time.Time          `json:"revertedTo"`
+	Item       *model.BacklogItem `json:"item"`
+}
+
+// NewItemRevertedEvent creates a new item reverted event
+func NewItemRevertedEvent(itemID uuid.UUID, revertedTo time.Time, item *model.BacklogItem) *ItemRevertedEvent {
+	return &ItemRevertedEvent{
+		Event:      NewBaseEvent(EventTypeItemReverted),
+		ItemID:     itemID,
+		RevertedTo: revertedTo,
+		Item:       item,
+	}
+}
+
+// ItemPublishedEvent represents an event when a draft item is published
+type ItemPublishedEvent struct {
+	Event
+	ItemID uuid.UUID          `json:"itemId"`
+	Item   *model.BacklogItem `json:"item"`
+}
+
+// NewItemPublishedEvent creates a new item published event
+func NewItemPublishedEvent(itemID uuid.UUID, item *model.BacklogItem) *ItemPublishedEvent {
+	return &ItemPublishedEvent{
+		Event:  NewBaseEvent(EventTypeItemPublished),
+		ItemID: itemID,
+		Item:   item,
+	}
+}
+
+// TagRenamedEvent represents one chunk of a tag rename. Large renames are
+// split into chunks so downstream consumers holding the old tag can apply
+// them without unbounded payloads; Chunk/TotalChunks tie the chunks together.
+type TagRenamedEvent struct {
+	Event
+	OldTag      string      `json:"oldTag"`
+	NewTag      string      `json:"newTag"`
+	ItemIDs     []uuid.UUID `json:"itemIds"`
+	Chunk       int         `json:"chunk"`
+	TotalChunks int         `json:"totalChunks"`
+}
+
+// NewTagRenamedEvent creates a new tag renamed event chunk
+func NewTagRenamedEvent(oldTag, newTag string, itemIDs []uuid.UUID, chunk, totalChunks int) *TagRenamedEvent {
+	return &TagRenamedEvent{
+		Event:       NewBaseEvent(EventTypeTagRenamed),
+		OldTag:      oldTag,
+		NewTag:      newTag,
+		ItemIDs:     itemIDs,
+		Chunk:       chunk,
+		TotalChunks: totalChunks,
+	}
+}
+
+// TagsMergedEvent represents one chunk of a tag merge
+type TagsMergedEvent struct {
+	Event
+	SourceTags  []string    `json:"sourceTags"`
+	TargetTag   string      `json:"targetTag"`
+	ItemIDs     []uuid.UUID `json:"itemIds"`
+	Chunk       int         `json:"chunk"`
+	TotalChunks int         `json:"totalChunks"`
+}
+
+// NewTagsMergedEvent creates a new tags merged event chunk
+func NewTagsMergedEvent(sourceTags []string, targetTag string, itemIDs []uuid.UUID, chunk, totalChunks int) *TagsMergedEvent {
+	return &TagsMergedEvent{
+		Event:       NewBaseEvent(EventTypeTagsMerged),
+		SourceTags:  sourceTags,
+		TargetTag:   targetTag,
+		ItemIDs:     itemIDs,
+		Chunk:       chunk,
+		TotalChunks: totalChunks,
+	}
+}
+
+// NewUserMentionedEvent creates a new user mentioned event
+func NewUserMentionedEvent(itemID uuid.UUID, userID string) *UserMentionedEvent {
+	return &UserMentionedEvent{
+		Event:  NewBaseEvent(EventTypeUserMentioned),
+		ItemID: itemID,
+		UserID: userID,
+	}
+}
+
 // NewExternalIDSetEvent creates a new external ID set event
 func NewExternalIDSetEvent(itemID uuid.UUID, system, externalID string) *ExternalIDSetEvent {
 	return &ExternalIDSetEvent{
@@ -123,3 +648,148 @@ func NewExternalIDSetEvent(itemID uuid.UUID, system, externalID string) *Externa
 		ExternalID: externalID,
 	}
 }
+
+// NewExternalIDRemovedEvent creates a new external ID removed event
+func NewExternalIDRemovedEvent(itemID uuid.UUID, system, externalID string) *ExternalIDRemovedEvent {
+	return &ExternalIDRemovedEvent{
+		Event:      NewBaseEvent(EventTypeExternalIDRemoved),
+		ItemID:     itemID,
+		System:     system,
+		ExternalID: externalID,
+	}
+}
+
+// MonthlyUsageEvent represents one workspace's usage rollup for a closed
+// month
+type MonthlyUsageEvent struct {
+	Event
+	WorkspaceID uuid.UUID        `json:"workspaceId"`
+	PeriodStart time.Time        `json:"periodStart"`
+	PeriodEnd   time.Time        `json:"periodEnd"`
+	Metrics     map[string]int64 `json:"metrics"`
+	ActiveUsers int              `json:"activeUsers"`
+}
+
+// NewMonthlyUsageEvent creates a new monthly usage event
+func NewMonthlyUsageEvent(workspaceID uuid.UUID, periodStart, periodEnd time.Time, metrics map[string]int64, activeUsers int) *MonthlyUsageEvent {
+	return &MonthlyUsageEvent{
+		Event:       NewBaseEvent(EventTypeMonthlyUsage),
+		WorkspaceID: workspaceID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Metrics:     metrics,
+		ActiveUsers: activeUsers,
+	}
+}
+
+// RetentionPolicyChangedEvent represents a workspace retention policy
+// change; old and new hold the retention windows in days per category so
+// the audit trail shows exactly what changed
+type RetentionPolicyChangedEvent struct {
+	Event
+	WorkspaceID uuid.UUID      `json:"workspaceId"`
+	Old         map[string]int `json:"old"`
+	New         map[string]int `json:"new"`
+	Actor       string         `json:"actor,omitempty"`
+}
+
+// NewRetentionPolicyChangedEvent creates a new retention policy changed event
+func NewRetentionPolicyChangedEvent(workspaceID uuid.UUID, old, updated map[string]int, actor string) *RetentionPolicyChangedEvent {
+	return &RetentionPolicyChangedEvent{
+		Event:       NewBaseEvent(EventTypeRetentionPolicyChanged),
+		WorkspaceID: workspaceID,
+		Old:         old,
+		New:         updated,
+		Actor:       actor,
+	}
+}
+
+// ScopeChangedEvent represents one change to an active sprint's scope: an
+// item added, removed, or re-sized inside the sprint. PointsDelta is the
+// signed effect on committed points.
+type ScopeChangedEvent struct {
+	Event
+	SprintID     uuid.UUID `json:"sprintId"`
+	ItemID       uuid.UUID `json:"itemId"`
+	Change       string    `json:"change"`
+	PointsBefore int       `json:"pointsBefore"`
+	PointsAfter  int       `json:"pointsAfter"`
+	PointsDelta  int       `json:"pointsDelta"`
+	Actor        string    `json:"actor,omitempty"`
+}
+
+// NewScopeChangedEvent creates a new scope changed event
+func NewScopeChangedEvent(sprintID, itemID uuid.UUID, change string, pointsBefore, pointsAfter int, actor string) *ScopeChangedEvent {
+	return &ScopeChangedEvent{
+		Event:        NewBaseEvent(EventTypeScopeChanged),
+		SprintID:     sprintID,
+		ItemID:       itemID,
+		Change:       change,
+		PointsBefore: pointsBefore,
+		PointsAfter:  pointsAfter,
+		PointsDelta:  pointsAfter - pointsBefore,
+		Actor:        actor,
+	}
+}
+
+// ItemExpiryWarningEvent represents a cold item entering its expiry grace
+// period; notification adapters fan it out to the item's watchers
+type ItemExpiryWarningEvent struct {
+	Event
+	ItemID    uuid.UUID `json:"itemId"`
+	IdleDays  float64   `json:"idleDays"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NewItemExpiryWarningEvent creates a new item expiry warning event
+func NewItemExpiryWarningEvent(itemID uuid.UUID, idleDays float64, expiresAt time.Time) *ItemExpiryWarningEvent {
+	return &ItemExpiryWarningEvent{
+		Event:     NewBaseEvent(EventTypeItemExpiryWarning),
+		ItemID:    itemID,
+		IdleDays:  idleDays,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// ItemExpiredEvent represents a cold item expired by policy; Action says
+// whether it was tagged or closed
+type ItemExpiredEvent struct {
+	Event
+	ItemID uuid.UUID `json:"itemId"`
+	Action string    `json:"action"`
+	Reason string    `json:"reason"`
+}
+
+// NewItemExpiredEvent creates a new item expired event
+func NewItemExpiredEvent(itemID uuid.UUID, action string) *ItemExpiredEvent {
+	return &ItemExpiredEvent{
+		Event:  NewBaseEvent(EventTypeItemExpired),
+		ItemID: itemID,
+		Action: action,
+		Reason: "expired",
+	}
+}
+
+// ItemEscalatedEvent represents an aging item reaching a rung of the
+// escalation ladder; NotifyTarget says who notification adapters should fan
+// the event out to
+type ItemEscalatedEvent struct {
+	Event
+	ItemID       uuid.UUID `json:"itemId"`
+	Level        int       `json:"level"`
+	Action       string    `json:"action"`
+	AgeDays      float64   `json:"ageDays"`
+	NotifyTarget string    `json:"notifyTarget,omitempty"`
+}
+
+// NewItemEscalatedEvent creates a new item escalated event
+func NewItemEscalatedEvent(itemID uuid.UUID, level int, action string, ageDays float64, notifyTarget string) *ItemEscalatedEvent {
+	return &ItemEscalatedEvent{
+		Event:        NewBaseEvent(EventTypeItemEscalated),
+		ItemID:       itemID,
+		Level:        level,
+		Action:       action,
+		AgeDays:      ageDays,
+		NotifyTarget: notifyTarget,
+	}
+}
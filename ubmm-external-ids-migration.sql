@@ -0,0 +1,47 @@
+-- services/backlog-service/migrations/000006_external_id_uniqueness.up.sql
+
+-- External IDs live in a JSONB map on backlog_items, which cannot enforce
+-- uniqueness of (system, external_id) on its own. This migration adds a
+-- mapping table kept in sync by trigger; its unique index is what rejects a
+-- second item claiming the same Jira key, and it doubles as a reverse-lookup
+-- index for hot sync paths.
+CREATE TABLE IF NOT EXISTS external_id_mappings (
+    system TEXT NOT NULL,
+    external_id TEXT NOT NULL,
+    item_id UUID NOT NULL REFERENCES backlog_items(id) ON DELETE CASCADE,
+    workspace_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Uniqueness is scoped per workspace; rows without a workspace share one scope
+CREATE UNIQUE INDEX idx_external_id_mappings_unique
+    ON external_id_mappings (COALESCE(workspace_id::TEXT, ''), system, external_id);
+
+CREATE INDEX idx_external_id_mappings_item_id ON external_id_mappings(item_id);
+
+-- Backfill from the existing JSONB maps
+INSERT INTO external_id_mappings (system, external_id, item_id, workspace_id)
+SELECT kv.key, kv.value, b.id, b.workspace_id
+FROM backlog_items b, jsonb_each_text(b.external_ids) kv;
+
+-- Keep the mapping table in sync with the JSONB column on every write
+CREATE OR REPLACE FUNCTION sync_external_id_mappings() RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM external_id_mappings WHERE item_id = NEW.id;
+    INSERT INTO external_id_mappings (system, external_id, item_id, workspace_id)
+    SELECT kv.key, kv.value, NEW.id, NEW.workspace_id
+    FROM jsonb_each_text(NEW.external_ids) kv;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_sync_external_id_mappings
+AFTER INSERT OR UPDATE OF external_ids ON backlog_items
+FOR EACH ROW
+EXECUTE FUNCTION sync_external_id_mappings();
+
+-- services/backlog-service/migrations/000006_external_id_uniqueness.down.sql
+
+DROP TRIGGER IF EXISTS trigger_sync_external_id_mappings ON backlog_items;
+DROP FUNCTION IF EXISTS sync_external_id_mappings();
+DROP TABLE IF EXISTS external_id_mappings;
@@ -0,0 +1,241 @@
+// services/backlog-service/internal/adapters/db/field_encryption.go
+
+package db
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// KeyProvider supplies data-encryption keys from KMS/Vault by version, so
+// rotation only requires introducing a new version
+type KeyProvider interface {
+	// CurrentKey returns the active key and its version identifier
+	CurrentKey(ctx context.Context) (key []byte, version string, err error)
+
+	// KeyByVersion returns the key for a specific version
+	KeyByVersion(ctx context.Context, version string) ([]byte, error)
+}
+
+// encryptedPrefix marks ciphertext values; the stored form is
+// "enc:<keyVersion>:<base64(nonce|ciphertext)>"
+const encryptedPrefix = "enc:"
+
+// FieldCipher encrypts and decrypts configured sensitive fields with AES-GCM.
+// Repositories call it transparently on the way in and out of Postgres.
+type FieldCipher struct {
+	keys   KeyProvider
+	fields map[string]bool
+	logger *zap.Logger
+}
+
+// NewFieldCipher creates a new field cipher. sensitiveFields lists the field
+// names (e.g. "description") to encrypt.
+func NewFieldCipher(keys KeyProvider, sensitiveFields []string, logger *zap.Logger) *FieldCipher {
+	fields := make(map[string]bool, len(sensitiveFields))
+	for _, field := range sensitiveFields {
+		fields[field] = true
+	}
+	return &FieldCipher{
+		keys:   keys,
+		fields: fields,
+		logger: logger,
+	}
+}
+
+// IsSensitive reports whether a field is configured for encryption
+func (c *FieldCipher) IsSensitive(field string) bool {
+	return c.fields[field]
+}
+
+// Encrypt encrypts a plaintext value with the current key. Already-encrypted
+// values pass through unchanged.
+func (c *FieldCipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if strings.HasPrefix(plaintext, encryptedPrefix) {
+		return plaintext, nil
+	}
+
+	key, version, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain encryption key: %w", err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedPrefix + version + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a stored value. Plaintext values (written before
+// encryption was enabled) pass through unchanged.
+func (c *FieldCipher) Decrypt(ctx context.Context, stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return stored, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(stored, encryptedPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("malformed encrypted value")
+	}
+	version, encoded := parts[0], parts[1]
+
+	key, err := c.keys.KeyByVersion(ctx, version)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain key version %s: %w", version, err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted value: %w", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted value too short")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// KeyVersion extracts the key version a stored value was encrypted with, or
+// empty for plaintext values
+func (c *FieldCipher) KeyVersion(stored string) string {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return ""
+	}
+	parts := strings.SplitN(strings.TrimPrefix(stored, encryptedPrefix), ":", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// newGCM builds an AES-GCM cipher from a key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
+
+// services/backlog-service/internal/adapters/db/reencrypt_job.go
+
+package db
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// ReencryptJob re-encrypts rows still sealed under an old key version after a
+// rotation, in batches to bound transaction size
+type ReencryptJob struct {
+	db     *sqlx.DB
+	cipher *FieldCipher
+	logger *zap.Logger
+}
+
+// NewReencryptJob creates a new re-encryption job
+func NewReencryptJob(db *sqlx.DB, cipher *FieldCipher, logger *zap.Logger) *ReencryptJob {
+	return &ReencryptJob{
+		db:     db,
+		cipher: cipher,
+		logger: logger,
+	}
+}
+
+// Run re-encrypts item descriptions not sealed with the current key version.
+// It returns the number of rows rewritten.
+func (j *ReencryptJob) Run(ctx context.Context, currentVersion string) (int, error) {
+	const batchSize = 200
+	rewritten := 0
+
+	for {
+		// Fetch a batch of rows encrypted under a different (or no) version
+		rows, err := j.db.QueryContext(ctx, `
+			SELECT id, description
+			FROM backlog_items
+			WHERE description NOT LIKE 'enc:' || $1 || ':%'
+			LIMIT $2
+		`, currentVersion, batchSize)
+		if err != nil {
+			return rewritten, err
+		}
+
+		type row struct {
+			id          string
+			description string
+		}
+		var batch []row
+		for rows.Next() {
+			var r row
+			if err := rows.Scan(&r.id, &r.description); err != nil {
+				rows.Close()
+				return rewritten, err
+			}
+			batch = append(batch, r)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return rewritten, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, r := range batch {
+			// Decrypt with the old key, then seal with the current one
+			plaintext, err := j.cipher.Decrypt(ctx, r.description)
+			if err != nil {
+				return rewritten, err
+			}
+			sealed, err := j.cipher.Encrypt(ctx, plaintext)
+			if err != nil {
+				return rewritten, err
+			}
+			if _, err := j.db.ExecContext(ctx,
+				`UPDATE backlog_items SET description = $1 WHERE id = $2`,
+				sealed, r.id); err != nil {
+				return rewritten, err
+			}
+			rewritten++
+		}
+	}
+
+	j.logger.Info("Re-encryption finished",
+		zap.String("keyVersion", currentVersion),
+		zap.Int("rows", rewritten))
+
+	return rewritten, nil
+}
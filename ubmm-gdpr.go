@@ -0,0 +1,152 @@
+// services/backlog-service/internal/domain/service/gdpr.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// UserDataBundle is the JSON export of everything associated with a user,
+// returned by the GDPR export endpoint
+type UserDataBundle struct {
+	UserID        string               `json:"userId"`
+	ExportedAt    time.Time            `json:"exportedAt"`
+	AuthoredItems []*model.BacklogItem `json:"authoredItems"`
+	AssignedItems []*model.BacklogItem `json:"assignedItems"`
+	WorkLogs      []*WorkLogEntry      `json:"workLogs"`
+	AuditEntries  []interface{}        `json:"auditEntries"`
+}
+
+// ErasureRecord is the audit trail entry for a completed anonymization
+type ErasureRecord struct {
+	ID            uuid.UUID `json:"id"`
+	UserID        string    `json:"userId"`
+	RequestedBy   string    `json:"requestedBy"`
+	ItemsScrubbed int       `json:"itemsScrubbed"`
+	ErasedAt      time.Time `json:"erasedAt"`
+}
+
+// ErasureAuditStore persists the erasure audit trail
+type ErasureAuditStore interface {
+	// Record stores an erasure record
+	Record(ctx context.Context, record *ErasureRecord) error
+}
+
+// anonymizedPlaceholder replaces personal identifiers in scrubbed records
+const anonymizedPlaceholder = "anonymized-user"
+
+// GDPRService implements the compliance endpoints: per-user data export and
+// anonymization that preserves aggregates
+type GDPRService struct {
+	repo     repository.BacklogRepository
+	worklogs WorkLogRepository
+	audit    ErasureAuditStore
+	logger   *zap.Logger
+}
+
+// NewGDPRService creates a new GDPR service
+func NewGDPRService(repo repository.BacklogRepository, worklogs WorkLogRepository, audit ErasureAuditStore, logger *zap.Logger) *GDPRService {
+	return &GDPRService{
+		repo:     repo,
+		worklogs: worklogs,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// ExportUserData collects all data associated with a user as a JSON bundle
+func (s *GDPRService) ExportUserData(ctx context.Context, userID string) (*UserDataBundle, error) {
+	if userID == "" {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	bundle := &UserDataBundle{
+		UserID:     userID,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	// Items the user filed
+	authored, _, err := s.repo.List(ctx, repository.BacklogFilter{Reporter: userID, Limit: 10000})
+	if err != nil {
+		return nil, err
+	}
+	bundle.AuthoredItems = authored
+
+	// Items assigned to the user
+	assigned, _, err := s.repo.List(ctx, repository.BacklogFilter{Assignee: userID, Limit: 10000})
+	if err != nil {
+		return nil, err
+	}
+	bundle.AssignedItems = assigned
+
+	// Work log entries across all time
+	worklogs, err := s.worklogs.GetByUser(ctx, userID, time.Time{}, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	bundle.WorkLogs = worklogs
+
+	return bundle, nil
+}
+
+// EraseUserData scrubs personal identifiers from items associated with a
+// user while preserving the items themselves (and so all aggregates), and
+// records the erasure in the audit trail
+func (s *GDPRService) EraseUserData(ctx context.Context, userID, requestedBy string) (*ErasureRecord, error) {
+	if userID == "" {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	scrubbed := 0
+
+	// Scrub the user as reporter
+	authored, _, err := s.repo.List(ctx, repository.BacklogFilter{Reporter: userID, Limit: 10000})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range authored {
+		item.Reporter = anonymizedPlaceholder
+		if err := s.repo.Update(ctx, item); err != nil {
+			return nil, err
+		}
+		scrubbed++
+	}
+
+	// Scrub the user as assignee
+	assigned, _, err := s.repo.List(ctx, repository.BacklogFilter{Assignee: userID, Limit: 10000})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range assigned {
+		item.Assignee = anonymizedPlaceholder
+		if err := s.repo.Update(ctx, item); err != nil {
+			return nil, err
+		}
+		scrubbed++
+	}
+
+	record := &ErasureRecord{
+		ID:            uuid.New(),
+		UserID:        userID,
+		RequestedBy:   requestedBy,
+		ItemsScrubbed: scrubbed,
+		ErasedAt:      time.Now().UTC(),
+	}
+	if err := s.audit.Record(ctx, record); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("User data erased",
+		zap.String("userId", userID),
+		zap.Int("itemsScrubbed", scrubbed))
+
+	return record, nil
+}
@@ -0,0 +1,230 @@
+// services/backlog-service/internal/adapters/integration/gitlab/connector.go
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// externalSystem is the key used in BacklogItem.ExternalIDs for GitLab
+const externalSystem = "gitlab"
+
+// ConflictPreference controls which side wins when both systems changed
+type ConflictPreference string
+
+const (
+	// ConflictPreferLocal keeps the backlog's version on conflict
+	ConflictPreferLocal ConflictPreference = "LOCAL"
+	// ConflictPreferRemote takes GitLab's version on conflict
+	ConflictPreferRemote ConflictPreference = "REMOTE"
+)
+
+// Config holds the GitLab connector configuration for a workspace
+type Config struct {
+	BaseURL            string
+	Token              string
+	ProjectID          string
+	WebhookSecret      string
+	ConflictPreference ConflictPreference
+
+	// StatusLabels maps backlog statuses to GitLab issue state/labels
+	StatusLabels map[model.ItemStatus]string
+}
+
+// Connector syncs backlog stories with GitLab issues: stories to issues,
+// tags to labels, and milestones to sprints/releases
+type Connector struct {
+	cfg     Config
+	client  *http.Client
+	repo    repository.BacklogRepository
+	backlog *service.BacklogService
+	logger  *zap.Logger
+}
+
+// NewConnector creates a new GitLab connector
+func NewConnector(cfg Config, repo repository.BacklogRepository, backlog *service.BacklogService, logger *zap.Logger) *Connector {
+	if cfg.ConflictPreference == "" {
+		cfg.ConflictPreference = ConflictPreferLocal
+	}
+	return &Connector{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		repo:    repo,
+		backlog: backlog,
+		logger:  logger,
+	}
+}
+
+// issue is the subset of the GitLab issue payload the connector uses
+type issue struct {
+	IID       int       `json:"iid"`
+	Title     string    `json:"title"`
+	Desc      string    `json:"description"`
+	State     string    `json:"state"`
+	Labels    []string  `json:"labels"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	UpdatedAt time.Time `json:"updated_at"`
+	WebURL    string    `json:"web_url"`
+}
+
+// PushItem creates or updates the GitLab issue backing a story
+func (c *Connector) PushItem(ctx context.Context, item *model.BacklogItem) error {
+	if item.Type != model.ItemTypeStory {
+		return nil
+	}
+
+	payload := map[string]interface{}{
+		"title":       item.Title,
+		"description": item.Description,
+		"labels":      item.Tags,
+	}
+
+	externalID := item.GetExternalID(externalSystem)
+	var method, url string
+	if externalID == "" {
+		method = http.MethodPost
+		url = fmt.Sprintf("%s/api/v4/projects/%s/issues", c.cfg.BaseURL, c.cfg.ProjectID)
+	} else {
+		method = http.MethodPut
+		url = fmt.Sprintf("%s/api/v4/projects/%s/issues/%s", c.cfg.BaseURL, c.cfg.ProjectID, externalID)
+	}
+
+	var created issue
+	if err := c.do(ctx, method, url, payload, &created); err != nil {
+		return fmt.Errorf("failed to push item to GitLab: %w", err)
+	}
+
+	// Record the GitLab issue IID as the external ID on first creation
+	if externalID == "" {
+		if err := c.backlog.SetExternalID(ctx, item.ID, externalSystem, fmt.Sprintf("%d", created.IID)); err != nil {
+			return fmt.Errorf("failed to store GitLab external ID: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// HandleWebhook processes a GitLab issue webhook payload and applies the
+// remote change to the matching backlog item per the conflict preference
+func (c *Connector) HandleWebhook(ctx context.Context, body []byte, token string) error {
+	// GitLab sends the configured secret in the X-Gitlab-Token header
+	if c.cfg.WebhookSecret != "" && token != c.cfg.WebhookSecret {
+		return fmt.Errorf("invalid webhook token")
+	}
+
+	var payload struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes issue  `json:"object_attributes"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("failed to parse GitLab webhook: %w", err)
+	}
+	if payload.ObjectKind != "issue" {
+		return nil
+	}
+
+	return c.applyRemoteChange(ctx, &payload.ObjectAttributes)
+}
+
+// applyRemoteChange syncs a GitLab issue back onto the backlog item
+func (c *Connector) applyRemoteChange(ctx context.Context, remote *issue) error {
+	externalID := fmt.Sprintf("%d", remote.IID)
+	item, err := c.repo.GetByExternalID(ctx, externalSystem, externalID)
+	if err != nil {
+		// No local counterpart yet; ignore rather than auto-import
+		c.logger.Debug("GitLab issue has no local item", zap.String("iid", externalID))
+		return nil
+	}
+
+	// Conflict resolution: if the local item changed after the remote issue
+	// and the workspace prefers local, drop the remote change
+	if c.cfg.ConflictPreference == ConflictPreferLocal && item.UpdatedAt.After(remote.UpdatedAt) {
+		c.logger.Info("Skipping GitLab change in favor of local edit",
+			zap.String("itemId", item.ID.String()),
+			zap.String("iid", externalID))
+		return nil
+	}
+
+	req := &service.UpdateItemRequest{
+		Title:       &remote.Title,
+		Description: &remote.Desc,
+		Tags:        &remote.Labels,
+	}
+	status := c.mapState(remote)
+	if status != "" {
+		req.Status = &status
+	}
+
+	if _, err := c.backlog.UpdateItem(ctx, item.ID, req); err != nil {
+		return fmt.Errorf("failed to apply GitLab change: %w", err)
+	}
+
+	return nil
+}
+
+// mapState translates GitLab issue state/labels to a backlog status
+func (c *Connector) mapState(remote *issue) model.ItemStatus {
+	if remote.State == "closed" {
+		return model.ItemStatusDone
+	}
+	for status, label := range c.cfg.StatusLabels {
+		for _, l := range remote.Labels {
+			if l == label {
+				return status
+			}
+		}
+	}
+	return ""
+}
+
+// do executes an authenticated GitLab API call
+func (c *Connector) do(ctx context.Context, method, url string, payload interface{}, out interface{}) error {
+	var body *bytes.Buffer
+	if payload != nil {
+		jsonBytes, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		body = bytes.NewBuffer(jsonBytes)
+	} else {
+		body = bytes.NewBuffer(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.cfg.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("GitLab API returned status %d", resp.StatusCode)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode GitLab response: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+// services/backlog-service/internal/adapters/grpc/server_v2.go
+
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+	pbv1 "github.com/ubmm/backlog-service/pkg/api/v1"
+	pbv2 "github.com/ubmm/backlog-service/pkg/api/v2"
+)
+
+// BacklogServerV2 implements the v2 gRPC API. v2 adds cursor pagination and
+// field masks; both versions are served from the same process while v1
+// clients migrate.
+type BacklogServerV2 struct {
+	pbv2.UnimplementedBacklogServiceServer
+	service *service.BacklogService
+	logger  *zap.Logger
+}
+
+// NewBacklogServerV2 creates a new v2 backlog gRPC server
+func NewBacklogServerV2(service *service.BacklogService, logger *zap.Logger) *BacklogServerV2 {
+	return &BacklogServerV2{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// RegisterBoth wires the v1 and v2 servers onto one gRPC server. v1 keeps
+// serving unchanged apart from deprecation metadata on its responses.
+func RegisterBoth(server *grpc.Server, svc *service.BacklogService, logger *zap.Logger) {
+	pbv1.RegisterBacklogServiceServer(server, NewBacklogServer(svc, logger))
+	pbv2.RegisterBacklogServiceServer(server, NewBacklogServerV2(svc, logger))
+}
+
+// V1DeprecationInterceptor attaches deprecation metadata to every v1
+// response so clients can surface migration warnings
+func V1DeprecationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil && isV1Method(info.FullMethod) {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(
+				"deprecation", "true",
+				"sunset-version", "v2",
+				"link", "https://github.com/ubmm/backlog-service/blob/main/docs/api-v2-migration.md",
+			))
+		}
+		return resp, err
+	}
+}
+
+// isV1Method reports whether a full method path belongs to the v1 package
+func isV1Method(fullMethod string) bool {
+	const v1Prefix = "/ubmm.backlog.v1."
+	return len(fullMethod) >= len(v1Prefix) && fullMethod[:len(v1Prefix)] == v1Prefix
+}
+
+// GetItem retrieves an item, honoring the request's field mask
+func (s *BacklogServerV2) GetItem(ctx context.Context, req *pbv2.GetItemRequest) (*pbv2.BacklogItem, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid item ID")
+	}
+
+	item, err := s.service.GetItem(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+
+	return itemToProtoV2(item, req.FieldMask.GetPaths()), nil
+}
+
+// ListItems lists items with cursor pagination. The cursor is the offset of
+// the next page encoded by the previous response; empty means the first page.
+func (s *BacklogServerV2) ListItems(ctx context.Context, req *pbv2.ListItemsRequest) (*pbv2.ListItemsResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+	offset, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	filter := repository.BacklogFilter{
+		Limit:  pageSize,
+		Offset: offset,
+	}
+	for _, t := range req.Types {
+		filter.Types = append(filter.Types, model.ItemType(t))
+	}
+
+	items, total, err := s.service.ListItems(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list items")
+	}
+
+	resp := &pbv2.ListItemsResponse{
+		TotalCount: int32(total),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, itemToProtoV2(item, req.FieldMask.GetPaths()))
+	}
+	if int64(offset+len(items)) < total {
+		resp.NextCursor = encodeCursor(offset + len(items))
+	}
+
+	return resp, nil
+}
+
+// encodeCursor encodes a list offset as an opaque cursor
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodeCursor decodes an opaque cursor back to a list offset
+func decodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(decoded))
+}
+
+// itemToProtoV2 converts a domain item to its v2 proto form, keeping only
+// the masked fields when a mask is given
+func itemToProtoV2(item *model.BacklogItem, maskPaths []string) *pbv2.BacklogItem {
+	proto := &pbv2.BacklogItem{
+		Id:          item.ID.String(),
+		Key:         item.Key,
+		Type:        string(item.Type),
+		Title:       item.Title,
+		Description: item.Description,
+		StoryPoints: int32(item.StoryPoints),
+		Status:      string(item.Status),
+		Priority:    int32(item.Priority),
+		Assignee:    item.Assignee,
+		Tags:        item.Tags,
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+		UpdatedAt:   timestamppb.New(item.UpdatedAt),
+	}
+	if item.ParentID != nil {
+		proto.ParentId = item.ParentID.String()
+	}
+	if len(maskPaths) == 0 {
+		return proto
+	}
+	return applyFieldMask(proto, maskPaths)
+}
+
+// applyFieldMask blanks every field not named in the mask. Id stays set so
+// responses remain addressable.
+func applyFieldMask(proto *pbv2.BacklogItem, paths []string) *pbv2.BacklogItem {
+	keep := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		keep[path] = true
+	}
+
+	masked := &pbv2.BacklogItem{Id: proto.Id}
+	if keep["key"] {
+		masked.Key = proto.Key
+	}
+	if keep["type"] {
+		masked.Type = proto.Type
+	}
+	if keep["parent_id"] {
+		masked.ParentId = proto.ParentId
+	}
+	if keep["title"] {
+		masked.Title = proto.Title
+	}
+	if keep["description"] {
+		masked.Description = proto.Description
+	}
+	if keep["story_points"] {
+		masked.StoryPoints = proto.StoryPoints
+	}
+	if keep["status"] {
+		masked.Status = proto.Status
+	}
+	if keep["priority"] {
+		masked.Priority = proto.Priority
+	}
+	if keep["assignee"] {
+		masked.Assignee = proto.Assignee
+	}
+	if keep["tags"] {
+		masked.Tags = proto.Tags
+	}
+	if keep["created_at"] {
+		masked.CreatedAt = proto.CreatedAt
+	}
+	if keep["updated_at"] {
+		masked.UpdatedAt = proto.UpdatedAt
+	}
+	return masked
+}
@@ -0,0 +1,488 @@
+// services/backlog-service/internal/domain/service/integrity.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// Integrity finding kinds, stable strings so tooling can filter on them
+const (
+	IntegrityOrphanedParent   = "ORPHANED_PARENT"
+	IntegrityInvalidHierarchy = "INVALID_HIERARCHY"
+	IntegrityRankCollision    = "RANK_COLLISION"
+	IntegrityCacheDrift       = "CACHE_DRIFT"
+)
+
+// IntegrityFinding is one integrity problem found during a check
+type IntegrityFinding struct {
+	ItemID   uuid.UUID `json:"itemId"`
+	Kind     string    `json:"kind"`
+	Detail   string    `json:"detail"`
+	Repaired bool      `json:"repaired"`
+}
+
+// IntegrityReport summarizes an integrity check run
+type IntegrityReport struct {
+	ItemsChecked   int                 `json:"itemsChecked"`
+	Findings       []*IntegrityFinding `json:"findings"`
+	RepairsApplied int                 `json:"repairsApplied"`
+}
+
+// CheckIntegrity walks the whole backlog verifying referential integrity:
+// parent references that point nowhere, parent-child pairs the type registry
+// forbids, sibling rank collisions, and cached items that drifted from the
+// database. With repair set, safe fixes are applied as they are found.
+func (s *BacklogService) CheckIntegrity(ctx context.Context, repair bool) (*IntegrityReport, error) {
+	report := &IntegrityReport{}
+
+	// byID and byParent are built in one pass so the checks below need no
+	// further queries
+	byID := make(map[uuid.UUID]*model.BacklogItem)
+	byParent := make(map[uuid.UUID][]*model.BacklogItem)
+
+	offset := 0
+	for {
+		items, total, err := s.repo.List(ctx, repository.BacklogFilter{Limit: 200, Offset: offset, IncludeDrafts: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			byID[item.ID] = item
+			if item.ParentID != nil {
+				byParent[*item.ParentID] = append(byParent[*item.ParentID], item)
+			}
+		}
+		offset += len(items)
+		if int64(offset) >= total || len(items) == 0 {
+			break
+		}
+	}
+	report.ItemsChecked = len(byID)
+
+	// Parent reference checks
+	for _, item := range byID {
+		if item.ParentID == nil {
+			continue
+		}
+		parent, ok := byID[*item.ParentID]
+		if !ok {
+			finding := &IntegrityFinding{
+				ItemID: item.ID,
+				Kind:   IntegrityOrphanedParent,
+				Detail: fmt.Sprintf("parent %s does not exist", item.ParentID),
+			}
+			if repair {
+				finding.Repaired = s.repairClearParent(ctx, item)
+			}
+			s.record(report, finding)
+			continue
+		}
+		if !model.ActiveTypeRegistry().IsValidParentChild(parent.Type, item.Type) {
+			finding := &IntegrityFinding{
+				ItemID: item.ID,
+				Kind:   IntegrityInvalidHierarchy,
+				Detail: fmt.Sprintf("%s cannot be a child of %s", item.Type, parent.Type),
+			}
+			if repair {
+				finding.Repaired = s.repairClearParent(ctx, item)
+			}
+			s.record(report, finding)
+		}
+	}
+
+	// Sibling rank collision checks
+	for parentID, siblings := range byParent {
+		seen := make(map[int]uuid.UUID)
+		collided := false
+		for _, sibling := range siblings {
+			if sibling.ParentRank == 0 {
+				continue
+			}
+			if other, ok := seen[sibling.ParentRank]; ok {
+				finding := &IntegrityFinding{
+					ItemID: sibling.ID,
+					Kind:   IntegrityRankCollision,
+					Detail: fmt.Sprintf("parent rank %d collides with %s", sibling.ParentRank, other),
+				}
+				collided = true
+				s.record(report, finding)
+			}
+			seen[sibling.ParentRank] = sibling.ID
+		}
+		if collided && repair {
+			if err := s.repairReRank(ctx, parentID, siblings); err != nil {
+				s.logger.Error("Failed to re-rank siblings", zap.String("parentID", parentID.String()), zap.Error(err))
+			} else {
+				for _, finding := range report.Findings {
+					if finding.Kind == IntegrityRankCollision && !finding.Repaired {
+						finding.Repaired = true
+						report.RepairsApplied++
+					}
+				}
+			}
+		}
+	}
+
+	// Cache drift checks: a cached item whose UpdatedAt differs from the
+	// database row is serving stale reads
+	for _, item := range byID {
+		cached, err := s.cache.Get(ctx, "item:"+item.ID.String())
+		if err != nil || cached == nil {
+			continue
+		}
+		cachedItem, ok := cached.(*model.BacklogItem)
+		if !ok || cachedItem.UpdatedAt.Equal(item.UpdatedAt) {
+			continue
+		}
+		finding := &IntegrityFinding{
+			ItemID: item.ID,
+			Kind:   IntegrityCacheDrift,
+			Detail: fmt.Sprintf("cache has UpdatedAt %s, database has %s", cachedItem.UpdatedAt, item.UpdatedAt),
+		}
+		if repair {
+			finding.Repaired = s.cache.Delete(ctx, "item:"+item.ID.String()) == nil
+		}
+		s.record(report, finding)
+	}
+
+	return report, nil
+}
+
+// record appends a finding and counts its repair
+func (s *BacklogService) record(report *IntegrityReport, finding *IntegrityFinding) {
+	report.Findings = append(report.Findings, finding)
+	if finding.Repaired {
+		report.RepairsApplied++
+	}
+}
+
+// repairClearParent detaches an item from an invalid parent
+func (s *BacklogService) repairClearParent(ctx context.Context, item *model.BacklogItem) bool {
+	if err := item.UpdateParent(nil); err != nil {
+		return false
+	}
+	if err := s.repo.Update(ctx, item); err != nil {
+		s.logger.Error("Failed to clear invalid parent", zap.String("itemID", item.ID.String()), zap.Error(err))
+		return false
+	}
+	s.cache.Delete(ctx, "item:"+item.ID.String())
+	return true
+}
+
+// repairReRank assigns collision-free sequential ranks, keeping the current
+// order as closely as possible
+func (s *BacklogService) repairReRank(ctx context.Context, parentID uuid.UUID, siblings []*model.BacklogItem) error {
+	orderedIDs := make([]uuid.UUID, 0, len(siblings))
+	for _, sibling := range siblings {
+		orderedIDs = append(orderedIDs, sibling.ID)
+	}
+	return s.ReorderChildren(ctx, parentID, orderedIDs)
+}
+
+// services/backlog-service/internal/adapters/grpc/admin_server.go
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// AdminServer implements the gRPC admin service for operational commands
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	service     *service.BacklogService
+	coordinator *service.WorkerCoordinator
+	retention   *service.RetentionService
+	purge       *service.RetentionPurgeJob
+	config      *service.ConfigAsCodeService
+	logger      *zap.Logger
+}
+
+// NewAdminServer creates a new admin gRPC server
+func NewAdminServer(service *service.BacklogService, coordinator *service.WorkerCoordinator, retention *service.RetentionService, purge *service.RetentionPurgeJob, config *service.ConfigAsCodeService, logger *zap.Logger) *AdminServer {
+	return &AdminServer{
+		service:     service,
+		coordinator: coordinator,
+		retention:   retention,
+		purge:       purge,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// CheckIntegrity runs the backlog integrity check, optionally repairing
+func (s *AdminServer) CheckIntegrity(ctx context.Context, req *pb.CheckIntegrityRequest) (*pb.IntegrityReport, error) {
+	report, err := s.service.CheckIntegrity(ctx, req.Repair)
+	if err != nil {
+		s.logger.Error("Integrity check failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "integrity check failed: %v", err)
+	}
+
+	response := &pb.IntegrityReport{
+		ItemsChecked:   int32(report.ItemsChecked),
+		RepairsApplied: int32(report.RepairsApplied),
+	}
+	for _, finding := range report.Findings {
+		response.Findings = append(response.Findings, &pb.IntegrityFinding{
+			ItemId:   finding.ItemID.String(),
+			Kind:     finding.Kind,
+			Detail:   finding.Detail,
+			Repaired: finding.Repaired,
+		})
+	}
+
+	return response, nil
+}
+
+// ListWorkerLeases reports which replica owns each background work lease
+func (s *AdminServer) ListWorkerLeases(ctx context.Context, req *emptypb.Empty) (*pb.ListWorkerLeasesResponse, error) {
+	leases, err := s.coordinator.ListWorkerLeases(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list worker leases", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list worker leases: %v", err)
+	}
+
+	response := &pb.ListWorkerLeasesResponse{}
+	for _, lease := range leases {
+		response.Leases = append(response.Leases, &pb.WorkerLease{
+			Name:       lease.Name,
+			Owner:      lease.Owner,
+			AcquiredAt: timestamppb.New(lease.AcquiredAt),
+			ExpiresAt:  timestamppb.New(lease.ExpiresAt),
+		})
+	}
+
+	return response, nil
+}
+
+// GetRetentionPolicy retrieves a workspace's data retention policy
+func (s *AdminServer) GetRetentionPolicy(ctx context.Context, req *pb.GetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace ID: %v", err)
+	}
+
+	policy, err := s.retention.GetRetentionPolicy(ctx, workspaceID)
+	if err != nil {
+		s.logger.Error("Failed to get retention policy", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get retention policy: %v", err)
+	}
+	if policy == nil {
+		return nil, status.Error(codes.NotFound, "no retention policy configured")
+	}
+
+	return convertToProtoRetentionPolicy(policy), nil
+}
+
+// SetRetentionPolicy creates or replaces a workspace's retention policy
+func (s *AdminServer) SetRetentionPolicy(ctx context.Context, req *pb.SetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+	workspaceID, err := uuid.Parse(req.Policy.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace ID: %v", err)
+	}
+
+	policy := &repository.RetentionPolicy{
+		WorkspaceID:       workspaceID,
+		EventsDays:        int(req.Policy.EventsDays),
+		AuditDays:         int(req.Policy.AuditDays),
+		ActivityDays:      int(req.Policy.ActivityDays),
+		ArchivedItemsDays: int(req.Policy.ArchivedItemsDays),
+	}
+	if err := s.retention.SetRetentionPolicy(ctx, policy, req.Actor); err != nil {
+		s.logger.Error("Failed to set retention policy", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set retention policy: %v", err)
+	}
+
+	return convertToProtoRetentionPolicy(policy), nil
+}
+
+// RunRetentionPurge runs the retention purge immediately, optionally as a
+// dry run that only reports what would be deleted
+func (s *AdminServer) RunRetentionPurge(ctx context.Context, req *pb.RunRetentionPurgeRequest) (*pb.RunRetentionPurgeResponse, error) {
+	reports, err := s.purge.Run(ctx, req.DryRun)
+	if err != nil {
+		s.logger.Error("Retention purge failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "retention purge failed: %v", err)
+	}
+
+	response := &pb.RunRetentionPurgeResponse{}
+	for _, report := range reports {
+		response.Reports = append(response.Reports, &pb.PurgeReport{
+			WorkspaceId:     report.WorkspaceID.String(),
+			DryRun:          report.DryRun,
+			Events:          report.Events,
+			AuditEntries:    report.AuditEntries,
+			ActivityEntries: report.ActivityEntries,
+			ArchivedItems:   report.ArchivedItems,
+		})
+	}
+
+	return response, nil
+}
+
+// ExportWorkspaceConfig exports the workspace configuration as YAML
+func (s *AdminServer) ExportWorkspaceConfig(ctx context.Context, req *emptypb.Empty) (*pb.WorkspaceConfigDocument, error) {
+	data, err := s.config.ExportYAML()
+	if err != nil {
+		s.logger.Error("Failed to export workspace config", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to export workspace config: %v", err)
+	}
+
+	return &pb.WorkspaceConfigDocument{Yaml: string(data)}, nil
+}
+
+// DiffWorkspaceConfig diffs a configuration document against the live
+// configuration without applying it
+func (s *AdminServer) DiffWorkspaceConfig(ctx context.Context, req *pb.WorkspaceConfigDocument) (*pb.WorkspaceConfigDiff, error) {
+	config, err := service.ParseWorkspaceConfig([]byte(req.Yaml))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace config: %v", err)
+	}
+
+	return convertToProtoConfigDiff(s.config.Diff(config)), nil
+}
+
+// ApplyWorkspaceConfig applies a configuration document, making the live
+// configuration match it
+func (s *AdminServer) ApplyWorkspaceConfig(ctx context.Context, req *pb.WorkspaceConfigDocument) (*pb.WorkspaceConfigDiff, error) {
+	config, err := service.ParseWorkspaceConfig([]byte(req.Yaml))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace config: %v", err)
+	}
+
+	changes, err := s.config.Apply(config)
+	if err != nil {
+		s.logger.Error("Failed to apply workspace config", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to apply workspace config: %v", err)
+	}
+
+	return convertToProtoConfigDiff(changes), nil
+}
+
+// convertToProtoConfigDiff converts config changes to proto
+func convertToProtoConfigDiff(changes []service.ConfigChange) *pb.WorkspaceConfigDiff {
+	diff := &pb.WorkspaceConfigDiff{}
+	for _, change := range changes {
+		diff.Changes = append(diff.Changes, &pb.WorkspaceConfigChange{
+			Section: change.Section,
+			Action:  change.Action,
+			Key:     change.Key,
+			Detail:  change.Detail,
+		})
+	}
+	return diff
+}
+
+// convertToProtoRetentionPolicy converts a domain retention policy to proto
+func convertToProtoRetentionPolicy(policy *repository.RetentionPolicy) *pb.RetentionPolicy {
+	return &pb.RetentionPolicy{
+		WorkspaceId:       policy.WorkspaceID.String(),
+		EventsDays:        int32(policy.EventsDays),
+		AuditDays:         int32(policy.AuditDays),
+		ActivityDays:      int32(policy.ActivityDays),
+		ArchivedItemsDays: int32(policy.ArchivedItemsDays),
+	}
+}
+
+// services/backlog-service/cmd/admin/main.go
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// admin runs operational commands against a live backlog service:
+//
+//	go run ./cmd/admin -addr localhost:50051 integrity
+//	go run ./cmd/admin -addr localhost:50051 -repair integrity
+//	go run ./cmd/admin -addr localhost:50051 leases
+func main() {
+	var (
+		addr   = flag.String("addr", "localhost:50051", "gRPC address of the backlog service")
+		repair = flag.Bool("repair", false, "apply safe repairs for findings")
+	)
+	flag.Parse()
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client := pb.NewAdminServiceClient(conn)
+	switch flag.Arg(0) {
+	case "integrity":
+		runIntegrity(ctx, client, *repair)
+	case "leases":
+		runLeases(ctx, client)
+	default:
+		log.Fatalf("unknown command %q; supported commands: integrity, leases", flag.Arg(0))
+	}
+}
+
+// runIntegrity runs the integrity check and prints its findings
+func runIntegrity(ctx context.Context, client pb.AdminServiceClient, repair bool) {
+	report, err := client.CheckIntegrity(ctx, &pb.CheckIntegrityRequest{Repair: repair})
+	if err != nil {
+		log.Fatalf("integrity check failed: %v", err)
+	}
+
+	fmt.Printf("checked %d items, %d findings, %d repairs applied\n",
+		report.ItemsChecked, len(report.Findings), report.RepairsApplied)
+	for _, finding := range report.Findings {
+		state := " "
+		if finding.Repaired {
+			state = "fixed"
+		}
+		fmt.Printf("%-18s %-36s %-5s %s\n", finding.Kind, finding.ItemId, state, finding.Detail)
+	}
+}
+
+// runLeases prints which replica owns each background work lease
+func runLeases(ctx context.Context, client pb.AdminServiceClient) {
+	response, err := client.ListWorkerLeases(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Fatalf("failed to list worker leases: %v", err)
+	}
+
+	for _, lease := range response.Leases {
+		fmt.Printf("%-32s %-44s expires %s\n",
+			lease.Name, lease.Owner, lease.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,27 @@
+-- services/backlog-service/migrations/000004_item_keys.up.sql
+
+-- Human-readable item keys (e.g. UB-1234). UUIDs remain the primary key;
+-- the key is a unique conversational alias generated from a sequence.
+CREATE SEQUENCE backlog_item_key_seq;
+
+ALTER TABLE backlog_items ADD COLUMN item_key TEXT;
+
+-- Backfill existing rows in creation order so older items get lower numbers
+UPDATE backlog_items SET item_key = 'UB-' || seq.n
+FROM (
+    SELECT id, nextval('backlog_item_key_seq') AS n
+    FROM (SELECT id FROM backlog_items ORDER BY created_at) ordered
+) seq
+WHERE backlog_items.id = seq.id;
+
+ALTER TABLE backlog_items
+    ALTER COLUMN item_key SET DEFAULT 'UB-' || nextval('backlog_item_key_seq'),
+    ALTER COLUMN item_key SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_backlog_items_item_key ON backlog_items(item_key);
+
+-- services/backlog-service/migrations/000004_item_keys.down.sql
+
+DROP INDEX IF EXISTS idx_backlog_items_item_key;
+ALTER TABLE backlog_items DROP COLUMN item_key;
+DROP SEQUENCE IF EXISTS backlog_item_key_seq;
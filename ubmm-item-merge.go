@@ -0,0 +1,113 @@
+// services/backlog-service/internal/domain/service/item_merge.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// ItemReferenceMover re-points per-item records (comments, watchers, work
+// logs) from one item to another. The db adapter implements it so the whole
+// move runs inside a single transaction.
+type ItemReferenceMover interface {
+	// MoveItemReferences re-points all records referencing fromID to toID
+	MoveItemReferences(ctx context.Context, fromID, toID uuid.UUID) error
+}
+
+// MergeItems merges duplicate items into a survivor. Children of the
+// duplicates are re-parented onto the survivor, tags and external IDs are
+// merged in, comments and watchers are moved over, and the duplicates are
+// closed with a "merged-into" link back to the survivor.
+func (s *BacklogService) MergeItems(ctx context.Context, survivorID uuid.UUID, duplicateIDs []uuid.UUID, mover ItemReferenceMover) (*model.BacklogItem, error) {
+	if len(duplicateIDs) == 0 {
+		return nil, errors.New("no duplicates to merge")
+	}
+
+	survivor, err := s.repo.GetByID(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dupID := range duplicateIDs {
+		if dupID == survivorID {
+			return nil, errors.New("survivor cannot be its own duplicate")
+		}
+
+		dup, err := s.repo.GetByID(ctx, dupID)
+		if err != nil {
+			return nil, err
+		}
+		if dup.Type != survivor.Type {
+			return nil, fmt.Errorf("cannot merge %s into %s", dup.Type, survivor.Type)
+		}
+
+		// Re-parent the duplicate's children onto the survivor
+		children, err := s.repo.GetChildren(ctx, dupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if err := child.UpdateParent(&survivorID); err != nil {
+				return nil, err
+			}
+			if err := s.repo.Update(ctx, child); err != nil {
+				return nil, fmt.Errorf("failed to re-parent child: %w", err)
+			}
+			s.cache.Delete(ctx, "item:"+child.ID.String())
+		}
+
+		// Merge tags and external IDs into the survivor
+		for _, tag := range dup.Tags {
+			survivor.AddTag(tag)
+		}
+		for system, externalID := range dup.ExternalIDs {
+			if _, exists := survivor.ExternalIDs[system]; !exists {
+				survivor.SetExternalID(system, externalID)
+			}
+		}
+
+		// Move comments, watchers and other per-item records
+		if mover != nil {
+			if err := mover.MoveItemReferences(ctx, dupID, survivorID); err != nil {
+				return nil, fmt.Errorf("failed to move item references: %w", err)
+			}
+		}
+
+		// Close the duplicate with a link back to where its content went
+		dup.SetExternalID("merged-into", survivorID.String())
+		if err := dup.UpdateStatus(model.ItemStatusDone); err != nil {
+			return nil, err
+		}
+		if err := s.repo.Update(ctx, dup); err != nil {
+			return nil, fmt.Errorf("failed to close duplicate: %w", err)
+		}
+		s.cache.Delete(ctx, "item:"+dupID.String())
+	}
+
+	if err := s.repo.Update(ctx, survivor); err != nil {
+		return nil, fmt.Errorf("failed to update survivor: %w", err)
+	}
+
+	// Store and publish event
+	mergeEvent := event.NewItemsMergedEvent(survivorID, duplicateIDs)
+	if err := s.eventRepo.StoreEvent(ctx, mergeEvent); err != nil {
+		s.logger.Error("Failed to store items merged event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.merged", mergeEvent); err != nil {
+		s.logger.Error("Failed to publish items merged event", zap.Error(err))
+	}
+
+	// Invalidate cache
+	s.cache.Delete(ctx, "item:"+survivorID.String())
+	s.invalidateListCache(ctx)
+
+	return survivor, nil
+}
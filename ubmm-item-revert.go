@@ -0,0 +1,92 @@
+// services/backlog-service/internal/domain/service/revert.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// RevertItemToVersion reverts an item to the state it had at the given point
+// in time, reconstructed from its event history. The reverted state is
+// applied as a new change with its own event, so the revert itself stays in
+// the history and can be reverted again — nothing is ever rewritten.
+func (s *BacklogService) RevertItemToVersion(ctx context.Context, id uuid.UUID, at time.Time) (*model.BacklogItem, error) {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.reconstructItemAt(ctx, id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	// Identity and bookkeeping fields stay as they are now; the revert is a
+	// normal update, not time travel
+	snapshot.ID = current.ID
+	snapshot.Key = current.Key
+	snapshot.CreatedAt = current.CreatedAt
+	snapshot.ExternalIDs = current.ExternalIDs
+	snapshot.UpdatedAt = time.Now().UTC()
+
+	if err := s.repo.Update(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to apply reverted state: %w", err)
+	}
+
+	// Store and publish the revert event
+	revertEvent := event.NewItemRevertedEvent(id, at, snapshot)
+	if err := s.eventRepo.StoreEvent(ctx, revertEvent); err != nil {
+		s.logger.Error("Failed to store revert event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.reverted", revertEvent); err != nil {
+		s.logger.Error("Failed to publish revert event", zap.Error(err))
+	}
+
+	// Invalidate caches
+	s.cache.Delete(ctx, "item:"+id.String())
+	s.invalidateListCache(ctx)
+
+	return snapshot, nil
+}
+
+// reconstructItemAt replays the item's history up to and including the given
+// time and returns the state it had then. Created and updated events carry
+// full item snapshots, so the last one at or before the cut-off wins.
+func (s *BacklogService) reconstructItemAt(ctx context.Context, id uuid.UUID, at time.Time) (*model.BacklogItem, error) {
+	events, err := s.eventRepo.GetEventsByItemID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot *model.BacklogItem
+	for _, e := range events {
+		switch evt := e.(type) {
+		case *event.ItemCreatedEvent:
+			if evt.Timestamp.After(at) || evt.Item == nil {
+				continue
+			}
+			state := *evt.Item
+			snapshot = &state
+		case *event.ItemUpdatedEvent:
+			if evt.Timestamp.After(at) || evt.Item == nil {
+				continue
+			}
+			state := *evt.Item
+			snapshot = &state
+		}
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("no recorded state for item %s at or before %s", id, at.Format(time.RFC3339))
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,136 @@
+// services/backlog-service/internal/domain/service/item_split.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// SplitPart describes one of the stories an item is split into
+type SplitPart struct {
+	Title       string
+	Description string
+	StoryPoints int // 0 means take an even share of the original's points
+}
+
+// SplitItemRequest carries the parameters for splitting an item
+type SplitItemRequest struct {
+	Parts         []SplitPart
+	CloseOriginal bool
+}
+
+// SplitItem splits a story into several smaller stories. The new items
+// inherit the original's parent, assignee, tags and due date; story points
+// are distributed across parts that don't specify their own. Each new item
+// carries a "split-from" external ID pointing back at the original.
+func (s *BacklogService) SplitItem(ctx context.Context, id uuid.UUID, req *SplitItemRequest) ([]*model.BacklogItem, error) {
+	if len(req.Parts) < 2 {
+		return nil, errors.New("split requires at least two parts")
+	}
+
+	original, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if original.Type != model.ItemTypeStory {
+		return nil, errors.New("only stories can be split")
+	}
+	if original.Status == model.ItemStatusDone {
+		return nil, errors.New("cannot split a completed item")
+	}
+
+	// Distribute the original's points evenly across parts without their own
+	// estimate, front-loading the remainder
+	unestimated := 0
+	claimed := 0
+	for _, part := range req.Parts {
+		if part.StoryPoints > 0 {
+			claimed += part.StoryPoints
+		} else {
+			unestimated++
+		}
+	}
+	share, remainder := 0, 0
+	if unestimated > 0 && original.StoryPoints > claimed {
+		remaining := original.StoryPoints - claimed
+		share = remaining / unestimated
+		remainder = remaining % unestimated
+	}
+
+	newItems := make([]*model.BacklogItem, 0, len(req.Parts))
+	for _, part := range req.Parts {
+		item, err := model.NewBacklogItem(model.ItemTypeStory, part.Title, part.Description)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split part: %w", err)
+		}
+
+		// Inherit metadata from the original
+		item.ParentID = original.ParentID
+		item.Assignee = original.Assignee
+		item.Reporter = original.Reporter
+		item.Source = original.Source
+		item.DueDate = original.DueDate
+		for _, tag := range original.Tags {
+			item.AddTag(tag)
+		}
+
+		points := part.StoryPoints
+		if points == 0 {
+			points = share
+			if remainder > 0 {
+				points++
+				remainder--
+			}
+		}
+		if points > 0 {
+			if err := item.UpdateStoryPoints(points); err != nil {
+				return nil, err
+			}
+		}
+
+		item.SetExternalID("split-from", original.ID.String())
+
+		if err := s.repo.Create(ctx, item); err != nil {
+			return nil, fmt.Errorf("failed to create split item: %w", err)
+		}
+		newItems = append(newItems, item)
+	}
+
+	// Optionally close the original now that its scope lives elsewhere
+	if req.CloseOriginal {
+		if err := original.UpdateStatus(model.ItemStatusDone); err != nil {
+			return nil, err
+		}
+		if err := s.repo.Update(ctx, original); err != nil {
+			return nil, fmt.Errorf("failed to close original item: %w", err)
+		}
+	}
+
+	newIDs := make([]uuid.UUID, len(newItems))
+	for i, item := range newItems {
+		newIDs[i] = item.ID
+	}
+
+	// Store and publish event
+	splitEvent := event.NewItemSplitEvent(original.ID, newIDs, req.CloseOriginal)
+	if err := s.eventRepo.StoreEvent(ctx, splitEvent); err != nil {
+		s.logger.Error("Failed to store item split event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.split", splitEvent); err != nil {
+		s.logger.Error("Failed to publish item split event", zap.Error(err))
+	}
+
+	// Invalidate cache
+	s.cache.Delete(ctx, "item:"+original.ID.String())
+	s.invalidateListCache(ctx)
+
+	return newItems, nil
+}
@@ -0,0 +1,84 @@
+// services/backlog-service/internal/domain/model/item_style.go
+
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ItemStyle is the presentation metadata boards use to render visually
+// distinct cards
+type ItemStyle struct {
+	Color             string     `json:"color,omitempty"`
+	Icon              string     `json:"icon,omitempty"`
+	CoverAttachmentID *uuid.UUID `json:"coverAttachmentId,omitempty"`
+}
+
+// StylePalette is a workspace's allowed card colors and icons; styles are
+// validated against it server-side so boards stay visually coherent
+type StylePalette struct {
+	Colors []string `json:"colors"`
+	Icons  []string `json:"icons"`
+}
+
+// DefaultStylePalette returns the built-in palette
+func DefaultStylePalette() *StylePalette {
+	return &StylePalette{
+		Colors: []string{
+			"#904EE2", "#4BADE8", "#63BA3C", "#F2A33C",
+			"#E8384F", "#6B778C", "#00A3BF", "#FFC400",
+		},
+		Icons: []string{
+			"epic", "feature", "story", "spike", "task",
+			"bug", "flag", "star", "bolt",
+		},
+	}
+}
+
+// Allows reports whether a style only uses colors and icons from the palette
+func (p *StylePalette) Allows(style *ItemStyle) error {
+	if style.Color != "" && !contains(p.Colors, style.Color) {
+		return errors.New("color is not in the workspace palette")
+	}
+	if style.Icon != "" && !contains(p.Icons, style.Icon) {
+		return errors.New("icon is not in the workspace palette")
+	}
+	return nil
+}
+
+// activePalette is the palette style updates are validated against
+var activePalette = DefaultStylePalette()
+
+// SetStylePalette installs a workspace's style palette
+func SetStylePalette(palette *StylePalette) {
+	if palette != nil {
+		activePalette = palette
+	}
+}
+
+// UpdateStyle updates the item's presentation metadata after validating it
+// against the workspace palette
+func (i *BacklogItem) UpdateStyle(style *ItemStyle) error {
+	if style == nil {
+		return errors.New("style cannot be nil")
+	}
+	if err := activePalette.Allows(style); err != nil {
+		return err
+	}
+	i.Style = style
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// contains reports whether a string slice holds a value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+-- services/backlog-service/migrations/000005_configurable_item_types.up.sql
+
+-- Item types become workspace-configurable, so the closed Postgres enum is
+-- replaced with TEXT. Existing enum values carry over unchanged; validation
+-- moves to the application's type registry.
+ALTER TABLE backlog_items ALTER COLUMN type TYPE TEXT USING type::TEXT;
+DROP TYPE IF EXISTS item_type;
+
+-- Per-workspace type definitions with hierarchy rules and card styling
+CREATE TABLE item_type_definitions (
+    type TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    icon TEXT NOT NULL DEFAULT '',
+    color TEXT NOT NULL DEFAULT '',
+    allowed_children TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO item_type_definitions (type, name, icon, color, allowed_children) VALUES
+    ('EPIC',    'Epic',    'epic',    '#904EE2', '{FEATURE}'),
+    ('FEATURE', 'Feature', 'feature', '#4BADE8', '{STORY}'),
+    ('STORY',   'Story',   'story',   '#63BA3C', '{}');
+
+-- services/backlog-service/migrations/000005_configurable_item_types.down.sql
+
+DROP TABLE IF EXISTS item_type_definitions;
+
+CREATE TYPE item_type AS ENUM ('EPIC', 'FEATURE', 'STORY');
+ALTER TABLE backlog_items ALTER COLUMN type TYPE item_type USING type::item_type;
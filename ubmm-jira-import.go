@@ -0,0 +1,224 @@
+// services/backlog-service/internal/adapters/integration/jira/importer.go
+
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// externalSystem is the key used in BacklogItem.ExternalIDs for Jira
+const externalSystem = "jira"
+
+// Config holds the Jira importer configuration
+type Config struct {
+	BaseURL  string
+	Email    string
+	APIToken string
+
+	// JQL selects the issues to backfill, e.g. "project = PROJ ORDER BY rank"
+	JQL string
+
+	// TypeMapping maps Jira issue type names to backlog item types
+	TypeMapping map[string]model.ItemType
+
+	// StatusMapping maps Jira status names to backlog statuses
+	StatusMapping map[string]model.ItemStatus
+
+	// PageSize is the Jira search page size (default 100)
+	PageSize int
+}
+
+// Report summarizes a completed backfill
+type Report struct {
+	Total    int      `json:"total"`
+	Created  int      `json:"created"`
+	Skipped  int      `json:"skipped"`
+	Warnings []string `json:"warnings"`
+	Duration time.Duration `json:"duration"`
+}
+
+// jiraIssue is the subset of the Jira search payload the importer uses
+type jiraIssue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary     string `json:"summary"`
+		Description string `json:"description"`
+		IssueType   struct {
+			Name string `json:"name"`
+		} `json:"issuetype"`
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		Parent *struct {
+			Key string `json:"key"`
+		} `json:"parent"`
+		Labels []string `json:"labels"`
+		Rank   string   `json:"customfield_10019"` // LexoRank
+	} `json:"fields"`
+}
+
+// Importer performs a one-shot backfill of a Jira project into the backlog,
+// preserving the epic/feature/story hierarchy and rank order
+type Importer struct {
+	cfg     Config
+	client  *http.Client
+	backlog *service.BacklogService
+	logger  *zap.Logger
+}
+
+// NewImporter creates a new Jira importer
+func NewImporter(cfg Config, backlog *service.BacklogService, logger *zap.Logger) *Importer {
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = 100
+	}
+	return &Importer{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		backlog: backlog,
+		logger:  logger,
+	}
+}
+
+// Run pages through the Jira search API and creates the mapped hierarchy
+func (i *Importer) Run(ctx context.Context) (*Report, error) {
+	started := time.Now()
+	report := &Report{}
+
+	// Fetch everything first so parents can be created before children and
+	// rank order can be preserved across pages
+	issues, err := i.fetchAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Total = len(issues)
+
+	// Sort by Jira LexoRank so priorities reflect the original backlog order
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].Fields.Rank < issues[b].Fields.Rank
+	})
+
+	// Create top-down: epics, then features, then stories, so parents exist
+	// when their children are imported
+	createdByKey := make(map[string]uuid.UUID)
+	for _, pass := range []model.ItemType{model.ItemTypeEpic, model.ItemTypeFeature, model.ItemTypeStory} {
+		rank := 0
+		for _, issue := range issues {
+			itemType, ok := i.cfg.TypeMapping[issue.Fields.IssueType.Name]
+			if !ok {
+				if pass == model.ItemTypeEpic {
+					report.Skipped++
+					report.Warnings = append(report.Warnings,
+						fmt.Sprintf("issue %s has unmapped type %q", issue.Key, issue.Fields.IssueType.Name))
+				}
+				continue
+			}
+			if itemType != pass {
+				continue
+			}
+
+			var parentID *uuid.UUID
+			if issue.Fields.Parent != nil {
+				if id, ok := createdByKey[issue.Fields.Parent.Key]; ok {
+					parentID = &id
+				} else {
+					report.Warnings = append(report.Warnings,
+						fmt.Sprintf("issue %s references missing parent %s", issue.Key, issue.Fields.Parent.Key))
+				}
+			}
+
+			item, err := i.backlog.CreateItem(ctx, &service.CreateItemRequest{
+				Type:        itemType,
+				Title:       issue.Fields.Summary,
+				Description: issue.Fields.Description,
+				ParentID:    parentID,
+				Tags:        issue.Fields.Labels,
+			})
+			if err != nil {
+				return report, fmt.Errorf("failed to import issue %s: %w", issue.Key, err)
+			}
+			createdByKey[issue.Key] = item.ID
+			report.Created++
+
+			// Preserve the Jira key as the external ID
+			if err := i.backlog.SetExternalID(ctx, item.ID, externalSystem, issue.Key); err != nil {
+				i.logger.Error("Failed to store Jira key",
+					zap.String("key", issue.Key),
+					zap.Error(err))
+			}
+
+			// Apply the mapped status and the preserved rank
+			update := &service.UpdateItemRequest{Priority: &rank}
+			if status, ok := i.cfg.StatusMapping[issue.Fields.Status.Name]; ok {
+				update.Status = &status
+			}
+			if _, err := i.backlog.UpdateItem(ctx, item.ID, update); err != nil {
+				i.logger.Error("Failed to apply imported status/rank",
+					zap.String("key", issue.Key),
+					zap.Error(err))
+			}
+			rank++
+		}
+	}
+
+	report.Duration = time.Since(started)
+	i.logger.Info("Jira backfill finished",
+		zap.Int("total", report.Total),
+		zap.Int("created", report.Created),
+		zap.Int("skipped", report.Skipped),
+		zap.Duration("duration", report.Duration))
+
+	return report, nil
+}
+
+// fetchAll pages through the Jira search API until exhausted
+func (i *Importer) fetchAll(ctx context.Context) ([]jiraIssue, error) {
+	var all []jiraIssue
+	startAt := 0
+
+	for {
+		searchURL := fmt.Sprintf("%s/rest/api/2/search?jql=%s&startAt=%d&maxResults=%d",
+			i.cfg.BaseURL, url.QueryEscape(i.cfg.JQL), startAt, i.cfg.PageSize)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(i.cfg.Email, i.cfg.APIToken)
+
+		resp, err := i.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query Jira: %w", err)
+		}
+
+		var page struct {
+			StartAt int         `json:"startAt"`
+			Total   int         `json:"total"`
+			Issues  []jiraIssue `json:"issues"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Jira response: %w", err)
+		}
+
+		all = append(all, page.Issues...)
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return all, nil
+}
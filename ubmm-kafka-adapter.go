@@ -17,14 +17,15 @@ import (
 
 // KafkaAdapter implements the event publisher interface
 type KafkaAdapter struct {
-	producer *kafka.Producer
-	logger   *zap.Logger
+	producer      *kafka.Producer
+	transactional bool
+	logger        *zap.Logger
 }
 
 // NewKafkaAdapter creates a new Kafka adapter
 func NewKafkaAdapter(cfg config.KafkaConfig, logger *zap.Logger) (*KafkaAdapter, error) {
-	// Create Kafka producer
-	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+	// Base producer configuration
+	configMap := &kafka.ConfigMap{
 		"bootstrap.servers":        cfg.BootstrapServers,
 		"client.id":                cfg.ClientID,
 		"acks":                     "all",
@@ -36,20 +37,42 @@ func NewKafkaAdapter(cfg config.KafkaConfig, logger *zap.Logger) (*KafkaAdapter,
 		"linger.ms":                10,
 		"request.timeout.ms":       30000,
 		"message.timeout.ms":       60000,
-		
+
 		// Enable idempotent producer for exactly-once semantics
 		"enable.idempotence":       true,
-		
+
 		// Security settings
 		"security.protocol":        cfg.SecurityProtocol,
 		"sasl.mechanisms":          cfg.SASLMechanism,
 		"sasl.username":            cfg.SASLUsername,
 		"sasl.password":            cfg.SASLPassword,
-	})
+	}
+
+	// Opt-in transactional mode for consumers that cannot tolerate duplicates.
+	// Without a transactional ID the producer stays idempotent-only.
+	if cfg.TransactionalEnabled {
+		_ = configMap.SetKey("transactional.id", cfg.TransactionalID)
+		_ = configMap.SetKey("transaction.timeout.ms", int(cfg.TransactionTimeout.Milliseconds()))
+	}
+
+	// Create Kafka producer
+	producer, err := kafka.NewProducer(configMap)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Kafka producer: %w", err)
 	}
 
+	// Initialize transactions before first use when transactional mode is on
+	if cfg.TransactionalEnabled {
+		initCtx, cancel := context.WithTimeout(context.Background(), cfg.TransactionTimeout)
+		defer cancel()
+		if err := producer.InitTransactions(initCtx); err != nil {
+			producer.Close()
+			return nil, fmt.Errorf("failed to init Kafka transactions: %w", err)
+		}
+		logger.Info("Kafka transactional producer initialized",
+			zap.String("transactionalId", cfg.TransactionalID))
+	}
+
 	// Start event handling goroutine
 	go func() {
 		for e := range producer.Events() {
@@ -74,11 +97,61 @@ func NewKafkaAdapter(cfg config.KafkaConfig, logger *zap.Logger) (*KafkaAdapter,
 	}()
 
 	return &KafkaAdapter{
-		producer: producer,
-		logger:   logger,
+		producer:      producer,
+		transactional: cfg.TransactionalEnabled,
+		logger:        logger,
 	}, nil
 }
 
+// Transactional reports whether the adapter runs in transactional mode
+func (a *KafkaAdapter) Transactional() bool {
+	return a.transactional
+}
+
+// PublishBatch publishes a batch of events atomically. In transactional mode
+// the batch is wrapped in a Kafka transaction so the outbox relay can hand
+// over whole batches with exactly-once semantics; otherwise it degrades to
+// sequential idempotent publishes.
+func (a *KafkaAdapter) PublishBatch(ctx context.Context, topic string, events []interface{}) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if !a.transactional {
+		for _, event := range events {
+			if err := a.Publish(ctx, topic, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Begin a transaction for the batch
+	if err := a.producer.BeginTransaction(); err != nil {
+		return fmt.Errorf("failed to begin Kafka transaction: %w", err)
+	}
+
+	for _, event := range events {
+		if err := a.Publish(ctx, topic, event); err != nil {
+			// Abort the whole batch so the relay can retry it as a unit
+			if abortErr := a.producer.AbortTransaction(ctx); abortErr != nil {
+				a.logger.Error("Failed to abort Kafka transaction", zap.Error(abortErr))
+			}
+			return err
+		}
+	}
+
+	// Commit the transaction
+	if err := a.producer.CommitTransaction(ctx); err != nil {
+		if abortErr := a.producer.AbortTransaction(ctx); abortErr != nil {
+			a.logger.Error("Failed to abort Kafka transaction", zap.Error(abortErr))
+		}
+		return fmt.Errorf("failed to commit Kafka transaction: %w", err)
+	}
+
+	return nil
+}
+
 // Close closes the Kafka producer
 func (a *KafkaAdapter) Close() error {
 	// Wait for any outstanding messages to be delivered
@@ -104,29 +177,41 @@ func (a *KafkaAdapter) Publish(ctx context.Context, topic string, event interfac
 		key = []byte(fmt.Sprintf("%d", time.Now().UnixNano()))
 	}
 
+	headers := []kafka.Header{
+		{
+			Key:   "content-type",
+			Value: []byte("application/json"),
+		},
+		{
+			Key:   "source",
+			Value: []byte("backlog-service"),
+		},
+		{
+			Key:   "timestamp",
+			Value: []byte(fmt.Sprintf("%d", time.Now().Unix())),
+		},
+	}
+
+	// Prefix the key with the tenant so one workspace's events stay on one
+	// partition (ordered per tenant), and carry it as a header so consumers
+	// can route without parsing the payload
+	if tenant := TenantFromContext(ctx); tenant != "" {
+		key = append([]byte(tenant+":"), key...)
+		headers = append(headers, kafka.Header{
+			Key:   TenantHeaderKey,
+			Value: []byte(tenant),
+		})
+	}
+
 	// Deliver message to Kafka
 	message := &kafka.Message{
 		TopicPartition: kafka.TopicPartition{
 			Topic:     &topic,
 			Partition: kafka.PartitionAny,
 		},
-		Key:   key,
-		Value: jsonBytes,
-		// Add headers if needed
-		Headers: []kafka.Header{
-			{
-				Key:   "content-type",
-				Value: []byte("application/json"),
-			},
-			{
-				Key:   "source",
-				Value: []byte("backlog-service"),
-			},
-			{
-				Key:   "timestamp",
-				Value: []byte(fmt.Sprintf("%d", time.Now().Unix())),
-			},
-		},
+		Key:     key,
+		Value:   jsonBytes,
+		Headers: headers,
 	}
 
 	// Publish message
@@ -177,6 +262,16 @@ func (a *KafkaProducerAdapter) Send(ctx context.Context, topic string, key strin
 		},
 	}
 
+	// Same tenant routing as Publish: tenant-prefixed key for per-tenant
+	// partition ordering, tenant header for consumer-side filtering
+	if tenant := TenantFromContext(ctx); tenant != "" {
+		message.Key = append([]byte(tenant+":"), message.Key...)
+		message.Headers = append(message.Headers, kafka.Header{
+			Key:   TenantHeaderKey,
+			Value: []byte(tenant),
+		})
+	}
+
 	// Use context deadline if available
 	deadline, ok := ctx.Deadline()
 	if ok {
@@ -0,0 +1,284 @@
+// services/backlog-service/cmd/loadgen/main.go
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+	"github.com/ubmm/backlog-service/pkg/client"
+)
+
+// loadgen drives a realistic traffic mix against a running backlog service
+// and reports latency percentiles, for validating pagination and caching
+// changes before they ship. Run it against the docker-compose stack:
+//
+//	go run ./cmd/loadgen -addr localhost:50051 -items 5000 -duration 60s
+func main() {
+	var (
+		addr        = flag.String("addr", "localhost:50051", "gRPC address of the backlog service")
+		items       = flag.Int("items", 5000, "number of items to seed before the run")
+		duration    = flag.Duration("duration", 60*time.Second, "how long to drive load")
+		concurrency = flag.Int("concurrency", 16, "number of concurrent workers")
+		readRatio   = flag.Float64("read-ratio", 0.8, "fraction of operations that are reads")
+		postgresDSN = flag.String("postgres-dsn", "", "optional Postgres DSN for utilization sampling")
+		redisAddr   = flag.String("redis-addr", "", "optional Redis address for utilization sampling")
+	)
+	flag.Parse()
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+	c := client.New(conn, client.DefaultConfig())
+
+	ctx := context.Background()
+
+	// Seed phase
+	fmt.Printf("seeding %d items...\n", *items)
+	itemIDs, err := seed(ctx, c, *items)
+	if err != nil {
+		log.Fatalf("seeding failed: %v", err)
+	}
+
+	// Optional utilization samplers
+	stopSampling := startSamplers(ctx, *postgresDSN, *redisAddr)
+	defer stopSampling()
+
+	// Load phase
+	fmt.Printf("driving load for %s with %d workers (%.0f%% reads)...\n",
+		*duration, *concurrency, *readRatio*100)
+
+	recorder := newLatencyRecorder()
+	runCtx, cancel := context.WithTimeout(ctx, *duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			worker(runCtx, c, itemIDs, *readRatio, recorder, rand.New(rand.NewSource(seed)))
+		}(int64(w))
+	}
+	wg.Wait()
+
+	recorder.report(os.Stdout)
+}
+
+// seed creates the initial backlog the run reads against
+func seed(ctx context.Context, c *client.Client, count int) ([]string, error) {
+	ids := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		item, err := c.CreateItem(ctx, &pb.CreateItemRequest{
+			Type:        pb.ItemType_ITEM_TYPE_STORY,
+			Title:       fmt.Sprintf("loadgen story %d", i),
+			Description: "generated by cmd/loadgen",
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, item.Id)
+	}
+	return ids, nil
+}
+
+// worker drives the configured operation mix until the context expires.
+// Reads split between item gets and (heavier) list queries; writes split
+// between updates and reorders.
+func worker(ctx context.Context, c *client.Client, itemIDs []string, readRatio float64, recorder *latencyRecorder, rng *rand.Rand) {
+	for ctx.Err() == nil {
+		roll := rng.Float64()
+		start := time.Now()
+
+		switch {
+		case roll < readRatio*0.6:
+			_, err := c.GetItem(ctx, &pb.GetItemRequest{Id: itemIDs[rng.Intn(len(itemIDs))]})
+			recorder.record("get", time.Since(start), err)
+		case roll < readRatio:
+			_, err := c.ListItems(ctx, &pb.ListItemsRequest{PageSize: 50, Page: int32(rng.Intn(10))})
+			recorder.record("list", time.Since(start), err)
+		case roll < readRatio+(1-readRatio)*0.7:
+			title := fmt.Sprintf("loadgen story (touched %d)", rng.Int())
+			_, err := c.UpdateItem(ctx, &pb.UpdateItemRequest{
+				Id:    itemIDs[rng.Intn(len(itemIDs))],
+				Title: &title,
+			})
+			recorder.record("update", time.Since(start), err)
+		default:
+			// Reorder a small window of items
+			reorder(ctx, c, itemIDs, recorder, rng, start)
+		}
+	}
+}
+
+// reorder shuffles the priorities of a random slice of ten items
+func reorder(ctx context.Context, c *client.Client, itemIDs []string, recorder *latencyRecorder, rng *rand.Rand, start time.Time) {
+	// The SDK has no reorder wrapper; measure a burst of priority updates,
+	// which exercises the same write path
+	base := rng.Intn(len(itemIDs) - 10)
+	var err error
+	for i := 0; i < 10 && err == nil; i++ {
+		priority := int32(rng.Intn(1000))
+		_, err = c.UpdateItem(ctx, &pb.UpdateItemRequest{
+			Id:       itemIDs[base+i],
+			Priority: &priority,
+		})
+	}
+	recorder.record("reorder", time.Since(start), err)
+}
+
+// latencyRecorder collects per-operation latencies
+type latencyRecorder struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+}
+
+func newLatencyRecorder() *latencyRecorder {
+	return &latencyRecorder{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]int),
+	}
+}
+
+func (r *latencyRecorder) record(op string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.errors[op]++
+		return
+	}
+	r.latencies[op] = append(r.latencies[op], latency)
+}
+
+// report prints count, error count and latency percentiles per operation
+func (r *latencyRecorder) report(out *os.File) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ops := make([]string, 0, len(r.latencies))
+	for op := range r.latencies {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	fmt.Fprintf(out, "\n%-10s %8s %6s %10s %10s %10s %10s\n",
+		"op", "count", "errs", "p50", "p95", "p99", "max")
+	for _, op := range ops {
+		samples := r.latencies[op]
+		sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+		fmt.Fprintf(out, "%-10s %8d %6d %10v %10v %10v %10v\n",
+			op, len(samples), r.errors[op],
+			percentile(samples, 0.50),
+			percentile(samples, 0.95),
+			percentile(samples, 0.99),
+			samples[len(samples)-1])
+	}
+}
+
+// percentile picks the nearest-rank percentile from sorted samples
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted)-1) * p)
+	return sorted[idx]
+}
+
+// startSamplers polls DB and Redis utilization every five seconds when
+// configured, printing alongside the load so saturation is visible live
+func startSamplers(ctx context.Context, postgresDSN, redisAddr string) func() {
+	sampleCtx, cancel := context.WithCancel(ctx)
+
+	if postgresDSN != "" {
+		db, err := sqlx.Open("postgres", postgresDSN)
+		if err != nil {
+			log.Printf("postgres sampler disabled: %v", err)
+		} else {
+			go func() {
+				defer db.Close()
+				ticker := time.NewTicker(5 * time.Second)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-sampleCtx.Done():
+						return
+					case <-ticker.C:
+						var active int
+						if err := db.GetContext(sampleCtx, &active,
+							`SELECT COUNT(*) FROM pg_stat_activity WHERE state = 'active'`); err == nil {
+							fmt.Printf("[db] active connections: %d\n", active)
+						}
+					}
+				}
+			}()
+		}
+	}
+
+	if redisAddr != "" {
+		rdb := redis.NewClient(&redis.Options{Addr: redisAddr})
+		go func() {
+			defer rdb.Close()
+			ticker := time.NewTicker(5 * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-sampleCtx.Done():
+					return
+				case <-ticker.C:
+					if info, err := rdb.Info(sampleCtx, "stats").Result(); err == nil {
+						fmt.Printf("[redis] %s\n", firstLine(info, "instantaneous_ops_per_sec"))
+					}
+				}
+			}
+		}()
+	}
+
+	return cancel
+}
+
+// firstLine extracts the named field from a Redis INFO block
+func firstLine(info, field string) string {
+	for _, line := range splitLines(info) {
+		if len(line) > len(field) && line[:len(field)] == field {
+			return line
+		}
+	}
+	return field + ": unknown"
+}
+
+// splitLines splits on both CRLF and LF
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			end := i
+			if end > start && s[end-1] == '\r' {
+				end--
+			}
+			lines = append(lines, s[start:end])
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		lines = append(lines, s[start:])
+	}
+	return lines
+}
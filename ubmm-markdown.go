@@ -0,0 +1,175 @@
+// services/backlog-service/internal/domain/markdown/renderer.go
+
+package markdown
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"go.uber.org/zap"
+)
+
+// mentionPattern matches @username tokens in item descriptions
+var mentionPattern = regexp.MustCompile(`(^|\s)@([a-zA-Z0-9_.-]+)`)
+
+// UserResolver resolves @mention usernames to user IDs
+type UserResolver interface {
+	// ResolveUsername returns the user ID for a username, or empty if unknown
+	ResolveUsername(ctx context.Context, username string) (string, error)
+}
+
+// CacheProvider defines the caching interface used for rendered output
+type CacheProvider interface {
+	Get(ctx context.Context, key string) (interface{}, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// Renderer converts raw markdown descriptions to sanitized HTML. Rendering is
+// done on demand and cached; raw markdown remains the source of truth.
+type Renderer struct {
+	md       goldmark.Markdown
+	policy   *bluemonday.Policy
+	resolver UserResolver
+	cache    CacheProvider
+	logger   *zap.Logger
+}
+
+// NewRenderer creates a new markdown renderer
+func NewRenderer(resolver UserResolver, cache CacheProvider, logger *zap.Logger) *Renderer {
+	return &Renderer{
+		md: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+		),
+		policy:   bluemonday.UGCPolicy(),
+		resolver: resolver,
+		cache:    cache,
+		logger:   logger,
+	}
+}
+
+// Render converts raw markdown to sanitized HTML, using the cache when the
+// same content was rendered before
+func (r *Renderer) Render(ctx context.Context, raw string) (string, error) {
+	cacheKey := fmt.Sprintf("markdown:%x", hashContent(raw))
+	cached, err := r.cache.Get(ctx, cacheKey)
+	if err == nil && cached != nil {
+		if html, ok := cached.(string); ok {
+			return html, nil
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(raw), &buf); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	// Sanitize the rendered HTML before it leaves the service
+	html := r.policy.Sanitize(buf.String())
+
+	if err := r.cache.Set(ctx, cacheKey, html, 24*time.Hour); err != nil {
+		r.logger.Error("Failed to cache rendered markdown", zap.Error(err))
+	}
+
+	return html, nil
+}
+
+// ExtractMentions returns the resolved user IDs for all @mentions in the raw
+// markdown. Unknown usernames are skipped.
+func (r *Renderer) ExtractMentions(ctx context.Context, raw string) ([]string, error) {
+	matches := mentionPattern.FindAllStringSubmatch(raw, -1)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	var userIDs []string
+	for _, match := range matches {
+		username := match[2]
+		if seen[username] {
+			continue
+		}
+		seen[username] = true
+
+		userID, err := r.resolver.ResolveUsername(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve mention @%s: %w", username, err)
+		}
+		if userID != "" {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs, nil
+}
+
+// hashContent produces a stable cache key component for rendered content
+func hashContent(raw string) uint64 {
+	// FNV-1a, inlined to avoid importing hash/fnv at every call site
+	var h uint64 = 14695981039346656037
+	for i := 0; i < len(raw); i++ {
+		h ^= uint64(raw[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// services/backlog-service/internal/adapters/httpapi/markdown_preview.go
+
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/markdown"
+)
+
+// MarkdownPreviewHandler serves POST /preview/markdown, rendering raw
+// markdown to sanitized HTML without persisting anything
+type MarkdownPreviewHandler struct {
+	renderer *markdown.Renderer
+	logger   *zap.Logger
+}
+
+// NewMarkdownPreviewHandler creates a new markdown preview handler
+func NewMarkdownPreviewHandler(renderer *markdown.Renderer, logger *zap.Logger) *MarkdownPreviewHandler {
+	return &MarkdownPreviewHandler{
+		renderer: renderer,
+		logger:   logger,
+	}
+}
+
+// ServeHTTP handles the preview request
+func (h *MarkdownPreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		Raw string `json:"raw"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	html, err := h.renderer.Render(r.Context(), req.Raw)
+	if err != nil {
+		h.logger.Error("Failed to render markdown preview", zap.Error(err))
+		http.Error(w, "failed to render markdown", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"html": html})
+}
@@ -16,8 +16,9 @@ import (
 
 // MetricsRepository implements the metrics repository interface
 type MetricsRepository struct {
-	db     *sqlx.DB
-	logger *zap.Logger
+	db           *sqlx.DB
+	includeTasks bool
+	logger       *zap.Logger
 }
 
 // NewMetricsRepository creates a new metrics repository
@@ -28,14 +29,29 @@ func NewMetricsRepository(db *sqlx.DB, logger *zap.Logger) repository.MetricsRep
 	}
 }
 
+// SetIncludeTasks controls whether subtasks count toward backlog-size
+// metrics; they are excluded by default so task breakdown doesn't inflate
+// the iceberg
+func (r *MetricsRepository) SetIncludeTasks(include bool) {
+	r.includeTasks = include
+}
+
 // GetBacklogSize retrieves the current backlog size metrics
 func (r *MetricsRepository) GetBacklogSize(ctx context.Context) (map[model.ItemType]int, error) {
 	query := `
 		SELECT type, COUNT(*) as count
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE status != $1
 		GROUP BY type
 	`
+	if !r.includeTasks {
+		query = `
+			SELECT type, COUNT(*) as count
+			FROM active_backlog_items
+			WHERE status != $1 AND type != 'TASK'
+			GROUP BY type
+		`
+	}
 
 	rows, err := r.db.QueryContext(ctx, query, model.ItemStatusDone)
 	if err != nil {
@@ -77,7 +93,7 @@ func (r *MetricsRepository) GetItemAge(ctx context.Context, status model.ItemSta
 		SELECT 
 			type, 
 			AVG(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - created_at)) / 86400) as avg_age_days
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE status = $1
 		GROUP BY type
 	`
@@ -120,7 +136,7 @@ func (r *MetricsRepository) GetItemAge(ctx context.Context, status model.ItemSta
 func (r *MetricsRepository) GetWIPCounts(ctx context.Context) (int, error) {
 	query := `
 		SELECT COUNT(*) as wip_count
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE status = $1
 	`
 
@@ -139,11 +155,11 @@ func (r *MetricsRepository) GetLeadTime(ctx context.Context, timeWindowDays int)
 	// for items completed in the last timeWindowDays days
 	query := `
 		SELECT 
-			AVG(EXTRACT(EPOCH FROM (updated_at - created_at)) / 86400) as avg_lead_time_days
-		FROM backlog_items
+			AVG(EXTRACT(EPOCH FROM (completed_at - created_at)) / 86400) as avg_lead_time_days
+		FROM active_backlog_items
 		WHERE 
 			status = $1 AND 
-			updated_at >= NOW() - INTERVAL '1 day' * $2
+			completed_at >= NOW() - INTERVAL '1 day' * $2
 	`
 
 	var avgLeadTime float64
@@ -160,10 +176,10 @@ func (r *MetricsRepository) GetThroughput(ctx context.Context, timeWindowDays in
 	// Throughput is the number of items completed in the last timeWindowDays days
 	query := `
 		SELECT COUNT(*) as throughput
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE 
 			status = $1 AND 
-			updated_at >= NOW() - INTERVAL '1 day' * $2
+			completed_at >= NOW() - INTERVAL '1 day' * $2
 	`
 
 	var throughput int
@@ -175,6 +191,48 @@ func (r *MetricsRepository) GetThroughput(ctx context.Context, timeWindowDays in
 	return throughput, nil
 }
 
+// GetLeadTimeBetween retrieves lead time metrics within an explicit window.
+// Unlike GetLeadTime, the bounds are timezone-resolved by the caller so
+// "last 30 days" matches the workspace's local days.
+func (r *MetricsRepository) GetLeadTimeBetween(ctx context.Context, from, to time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(AVG(EXTRACT(EPOCH FROM (completed_at - created_at)) / 86400), 0) as lead_time_days
+		FROM active_backlog_items
+		WHERE
+			status = $1 AND
+			completed_at >= $2 AND
+			completed_at < $3
+	`
+
+	var leadTime float64
+	err := r.db.QueryRowContext(ctx, query, model.ItemStatusDone, from, to).Scan(&leadTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query lead time: %w", err)
+	}
+
+	return leadTime, nil
+}
+
+// GetThroughputBetween retrieves throughput within an explicit window
+func (r *MetricsRepository) GetThroughputBetween(ctx context.Context, from, to time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*) as throughput
+		FROM active_backlog_items
+		WHERE
+			status = $1 AND
+			completed_at >= $2 AND
+			completed_at < $3
+	`
+
+	var throughput int
+	err := r.db.QueryRowContext(ctx, query, model.ItemStatusDone, from, to).Scan(&throughput)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query throughput: %w", err)
+	}
+
+	return throughput, nil
+}
+
 // Additional metrics methods
 
 // GetStatusTransitionTimes calculates the average time spent in each status
@@ -242,8 +300,8 @@ func (r *MetricsRepository) GetBlockedItemsMetrics(ctx context.Context) (int, fl
 	query := `
 		SELECT 
 			COUNT(*) as blocked_count,
-			AVG(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - updated_at)) / 86400) as avg_blocked_days
-		FROM backlog_items
+			AVG(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - blocked_since)) / 86400) as avg_blocked_days
+		FROM active_backlog_items
 		WHERE status = $1
 	`
 
@@ -264,7 +322,7 @@ func (r *MetricsRepository) GetBlockedItemsMetrics(ctx context.Context) (int, fl
 func (r *MetricsRepository) GetAgeingItemsCount(ctx context.Context, thresholdDays int) (int, error) {
 	query := `
 		SELECT COUNT(*) as ageing_count
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE 
 			status != $1 AND
 			EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - created_at)) / 86400 > $2
@@ -284,10 +342,10 @@ func (r *MetricsRepository) GetStoryPointsProgress(ctx context.Context, timeWind
 	// Query for completed story points
 	completedQuery := `
 		SELECT COALESCE(SUM(story_points), 0) as completed_points
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE 
 			status = $1 AND
-			updated_at >= NOW() - INTERVAL '1 day' * $2
+			completed_at >= NOW() - INTERVAL '1 day' * $2
 	`
 
 	var completedPoints int
@@ -299,7 +357,7 @@ func (r *MetricsRepository) GetStoryPointsProgress(ctx context.Context, timeWind
 	// Query for total story points (both completed and in-progress)
 	totalQuery := `
 		SELECT COALESCE(SUM(story_points), 0) as total_points
-		FROM backlog_items
+		FROM active_backlog_items
 		WHERE created_at >= NOW() - INTERVAL '1 day' * $1
 	`
 
@@ -318,12 +376,94 @@ func (r *MetricsRepository) GetStoryPointsProgress(ctx context.Context, timeWind
 	return completedPoints, totalPoints, completionPercentage, nil
 }
 
+// GetOverdueCount retrieves the number of items past their due date
+func (r *MetricsRepository) GetOverdueCount(ctx context.Context) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM active_backlog_items
+		WHERE
+			due_date IS NOT NULL AND
+			due_date < NOW() AND
+			status != $1
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, model.ItemStatusDone).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query overdue count: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetWeeklyDemand retrieves items created and points added per week per type
+func (r *MetricsRepository) GetWeeklyDemand(ctx context.Context, timeWindowWeeks int) (map[model.ItemType]repository.WeeklyRate, error) {
+	query := `
+		SELECT
+			type,
+			COUNT(*)::float / $1 as items_per_week,
+			COALESCE(SUM(story_points), 0)::float / $1 as points_per_week
+		FROM active_backlog_items
+		WHERE created_at >= NOW() - INTERVAL '7 days' * $1
+		GROUP BY type
+	`
+	return r.queryWeeklyRates(ctx, query, timeWindowWeeks)
+}
+
+// GetWeeklyCompletion retrieves items completed and points completed per week
+// per type
+func (r *MetricsRepository) GetWeeklyCompletion(ctx context.Context, timeWindowWeeks int) (map[model.ItemType]repository.WeeklyRate, error) {
+	query := `
+		SELECT
+			type,
+			COUNT(*)::float / $1 as items_per_week,
+			COALESCE(SUM(story_points), 0)::float / $1 as points_per_week
+		FROM active_backlog_items
+		WHERE
+			status = 'DONE' AND
+			completed_at >= NOW() - INTERVAL '7 days' * $1
+		GROUP BY type
+	`
+	return r.queryWeeklyRates(ctx, query, timeWindowWeeks)
+}
+
+// queryWeeklyRates runs a per-type weekly rate query
+func (r *MetricsRepository) queryWeeklyRates(ctx context.Context, query string, timeWindowWeeks int) (map[model.ItemType]repository.WeeklyRate, error) {
+	rows, err := r.db.QueryContext(ctx, query, timeWindowWeeks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query weekly rates: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[model.ItemType]repository.WeeklyRate)
+
+	for rows.Next() {
+		var (
+			itemType model.ItemType
+			rate     repository.WeeklyRate
+		)
+
+		err := rows.Scan(&itemType, &rate.ItemsPerWeek, &rate.PointsPerWeek)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan weekly rates: %w", err)
+		}
+
+		result[itemType] = rate
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
 // GetItemTypeDistribution calculates the distribution of item types
 func (r *MetricsRepository) GetItemTypeDistribution(ctx context.Context) (map[model.ItemType]float64, error) {
 	query := `
 		WITH item_counts AS (
 			SELECT type, COUNT(*) as count
-			FROM backlog_items
+			FROM active_backlog_items
 			GROUP BY type
 		),
 		total AS (
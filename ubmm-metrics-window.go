@@ -0,0 +1,76 @@
+// services/backlog-service/internal/domain/service/metrics_window.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceTimezoneProvider resolves the configured IANA timezone for a
+// workspace; metrics windows and snapshot boundaries are computed in it
+// rather than in server time
+type WorkspaceTimezoneProvider interface {
+	// Timezone returns the workspace's IANA timezone name, e.g. "Europe/Amsterdam"
+	Timezone(ctx context.Context, workspaceID uuid.UUID) (string, error)
+}
+
+// MetricsWindow is an explicit half-open [From, To) window for metrics APIs
+type MetricsWindow struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// ResolveMetricsWindow computes the effective metrics window. Explicit from/to
+// take precedence; otherwise the window is the last windowDays full days in
+// the workspace's timezone, ending at the most recent local midnight.
+func ResolveMetricsWindow(ctx context.Context, tzProvider WorkspaceTimezoneProvider, workspaceID uuid.UUID, from, to *time.Time, windowDays int) (MetricsWindow, error) {
+	if from != nil && to != nil {
+		if to.Before(*from) {
+			return MetricsWindow{}, fmt.Errorf("metrics window end precedes start")
+		}
+		return MetricsWindow{From: from.UTC(), To: to.UTC()}, nil
+	}
+
+	tzName := "UTC"
+	if tzProvider != nil {
+		name, err := tzProvider.Timezone(ctx, workspaceID)
+		if err != nil {
+			return MetricsWindow{}, fmt.Errorf("failed to resolve workspace timezone: %w", err)
+		}
+		if name != "" {
+			tzName = name
+		}
+	}
+
+	location, err := time.LoadLocation(tzName)
+	if err != nil {
+		return MetricsWindow{}, fmt.Errorf("invalid workspace timezone %q: %w", tzName, err)
+	}
+
+	if windowDays <= 0 {
+		windowDays = 30
+	}
+
+	// Anchor on the most recent midnight in the workspace's timezone so
+	// "last 30 days" means the same thing for every team
+	now := time.Now().In(location)
+	localMidnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	end := localMidnight
+	start := end.AddDate(0, 0, -windowDays)
+
+	return MetricsWindow{From: start.UTC(), To: end.UTC()}, nil
+}
+
+// NextSnapshotTime returns the next local-midnight snapshot boundary for a
+// workspace, used by daily snapshot jobs
+func NextSnapshotTime(ctx context.Context, tzProvider WorkspaceTimezoneProvider, workspaceID uuid.UUID) (time.Time, error) {
+	window, err := ResolveMetricsWindow(ctx, tzProvider, workspaceID, nil, nil, 1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return window.To.AddDate(0, 0, 1), nil
+}
@@ -0,0 +1,293 @@
+// services/backlog-service/pkg/testing/mock_server.go
+
+package testing
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// MockServer is an in-memory BacklogService implementation for downstream
+// client tests: real gRPC wire behaviour, no database, no broker
+type MockServer struct {
+	pb.UnimplementedBacklogServiceServer
+
+	mu    sync.RWMutex
+	items map[string]*pb.BacklogItem
+}
+
+// NewMockServer creates an empty mock server
+func NewMockServer() *MockServer {
+	return &MockServer{items: make(map[string]*pb.BacklogItem)}
+}
+
+// Serve starts the mock on a random local port and returns its address and
+// a shutdown function
+func (m *MockServer) Serve() (string, func(), error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, err
+	}
+	server := grpc.NewServer()
+	pb.RegisterBacklogServiceServer(server, m)
+	go server.Serve(listener)
+	return listener.Addr().String(), server.Stop, nil
+}
+
+// Preload inserts items directly, bypassing validation, so tests can start
+// from a known state
+func (m *MockServer) Preload(items ...*pb.BacklogItem) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, item := range items {
+		m.items[item.Id] = item
+	}
+}
+
+// CreateItem creates an item in memory
+func (m *MockServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.BacklogItem, error) {
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title cannot be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := timestamppb.Now()
+	item := &pb.BacklogItem{
+		Id:          uuid.New().String(),
+		Type:        req.Type,
+		ParentId:    req.ParentId,
+		Title:       req.Title,
+		Description: req.Description,
+		StoryPoints: req.StoryPoints,
+		Status:      pb.ItemStatus_ITEM_STATUS_NEW,
+		Tags:        req.Tags,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	m.items[item.Id] = item
+	return item, nil
+}
+
+// GetItem retrieves an item by ID
+func (m *MockServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.BacklogItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	item, ok := m.items[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	return item, nil
+}
+
+// UpdateItem applies the set fields to a stored item
+func (m *MockServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.BacklogItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if req.Title != nil {
+		item.Title = *req.Title
+	}
+	if req.Description != nil {
+		item.Description = *req.Description
+	}
+	if req.Status != nil {
+		item.Status = *req.Status
+	}
+	if req.StoryPoints != nil {
+		item.StoryPoints = *req.StoryPoints
+	}
+	if req.Priority != nil {
+		item.Priority = *req.Priority
+	}
+	item.UpdatedAt = timestamppb.Now()
+	return item, nil
+}
+
+// DeleteItem removes an item
+func (m *MockServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*emptypb.Empty, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.items[req.Id]; !ok {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	delete(m.items, req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+// ListItems lists items sorted by priority with offset pagination
+func (m *MockServer) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	items := make([]*pb.BacklogItem, 0, len(m.items))
+	for _, item := range m.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Priority < items[j].Priority })
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	offset := int(req.Page) * pageSize
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return &pb.ListItemsResponse{
+		Items:      items[offset:end],
+		TotalCount: int32(len(items)),
+	}, nil
+}
+
+// services/backlog-service/pkg/testing/contract.go
+
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// Fixture is one recorded request/response pair the contract suite replays
+type Fixture struct {
+	Name       string          `json:"name"`
+	Method     string          `json:"method"` // e.g. "GetItem"
+	Request    json.RawMessage `json:"request"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	ErrorCode  string          `json:"errorCode,omitempty"` // expected gRPC code, e.g. "NotFound"
+}
+
+// Mismatch describes one fixture whose live behaviour diverged
+type Mismatch struct {
+	Fixture string
+	Detail  string
+}
+
+// LoadFixtures reads a fixture file (a JSON array of fixtures)
+func LoadFixtures(path string) ([]*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+	var fixtures []*Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	return fixtures, nil
+}
+
+// Verify replays every fixture against a client and reports mismatches.
+// Downstream teams run it in their own test suites against either the mock
+// server or a staging deployment.
+func Verify(ctx context.Context, client pb.BacklogServiceClient, fixtures []*Fixture) []Mismatch {
+	var mismatches []Mismatch
+	for _, fixture := range fixtures {
+		if detail := verifyOne(ctx, client, fixture); detail != "" {
+			mismatches = append(mismatches, Mismatch{Fixture: fixture.Name, Detail: detail})
+		}
+	}
+	return mismatches
+}
+
+// verifyOne replays a single fixture
+func verifyOne(ctx context.Context, client pb.BacklogServiceClient, fixture *Fixture) string {
+	var (
+		response proto.Message
+		err      error
+	)
+
+	switch fixture.Method {
+	case "GetItem":
+		req := &pb.GetItemRequest{}
+		if uerr := protojson.Unmarshal(fixture.Request, req); uerr != nil {
+			return fmt.Sprintf("bad request fixture: %v", uerr)
+		}
+		response, err = client.GetItem(ctx, req)
+	case "CreateItem":
+		req := &pb.CreateItemRequest{}
+		if uerr := protojson.Unmarshal(fixture.Request, req); uerr != nil {
+			return fmt.Sprintf("bad request fixture: %v", uerr)
+		}
+		response, err = client.CreateItem(ctx, req)
+	case "ListItems":
+		req := &pb.ListItemsRequest{}
+		if uerr := protojson.Unmarshal(fixture.Request, req); uerr != nil {
+			return fmt.Sprintf("bad request fixture: %v", uerr)
+		}
+		response, err = client.ListItems(ctx, req)
+	default:
+		return fmt.Sprintf("unsupported method %q", fixture.Method)
+	}
+
+	if fixture.ErrorCode != "" {
+		if err == nil {
+			return fmt.Sprintf("expected %s error, got success", fixture.ErrorCode)
+		}
+		if code := status.Code(err).String(); code != fixture.ErrorCode {
+			return fmt.Sprintf("expected %s error, got %s", fixture.ErrorCode, code)
+		}
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("unexpected error: %v", err)
+	}
+
+	expected := fixture.Response
+	if expected == nil {
+		return ""
+	}
+	actual, merr := protojson.Marshal(response)
+	if merr != nil {
+		return fmt.Sprintf("failed to marshal response: %v", merr)
+	}
+	if !jsonEqual(expected, actual) {
+		return fmt.Sprintf("response mismatch:\nexpected: %s\nactual:   %s", expected, actual)
+	}
+	return ""
+}
+
+// jsonEqual compares two JSON documents structurally
+func jsonEqual(a, b []byte) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal(a, &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return false
+	}
+	ab, _ := json.Marshal(av)
+	bb, _ := json.Marshal(bv)
+	return string(ab) == string(bb)
+}
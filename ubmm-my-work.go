@@ -0,0 +1,151 @@
+// services/backlog-service/internal/domain/service/my_work.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// WatcherRepository resolves which items a user watches
+type WatcherRepository interface {
+	// GetWatchedItemIDs retrieves the IDs of items the user watches
+	GetWatchedItemIDs(ctx context.Context, userID string) ([]uuid.UUID, error)
+}
+
+// Mention is one @mention of a user in a comment
+type Mention struct {
+	ItemID      uuid.UUID `json:"itemId"`
+	CommentID   uuid.UUID `json:"commentId"`
+	MentionedBy string    `json:"mentionedBy"`
+	Excerpt     string    `json:"excerpt"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// MentionRepository resolves recent comment mentions of a user
+type MentionRepository interface {
+	// GetRecentMentions retrieves mentions of the user since the given time
+	GetRecentMentions(ctx context.Context, userID string, since time.Time) ([]*Mention, error)
+}
+
+// MyWork is the personalized digest behind the CLI/UI "my work" view
+type MyWork struct {
+	UserID           string               `json:"userId"`
+	GeneratedAt      time.Time            `json:"generatedAt"`
+	AssignedItems    []*model.BacklogItem `json:"assignedItems"`
+	WatchedChanges   []*model.BacklogItem `json:"watchedChanges"`
+	Mentions         []*Mention           `json:"mentions"`
+	PendingApprovals []*Approval          `json:"pendingApprovals"`
+}
+
+// MyWorkService aggregates everything relevant to one user into a single
+// call, batching the underlying queries and caching the result briefly
+type MyWorkService struct {
+	backlog   *BacklogService
+	watchers  WatcherRepository
+	mentions  MentionRepository
+	approvals ApprovalRepository
+	cache     CacheProvider
+	logger    *zap.Logger
+}
+
+// NewMyWorkService creates a new my-work service
+func NewMyWorkService(backlog *BacklogService, watchers WatcherRepository, mentions MentionRepository, approvals ApprovalRepository, cache CacheProvider, logger *zap.Logger) *MyWorkService {
+	return &MyWorkService{
+		backlog:   backlog,
+		watchers:  watchers,
+		mentions:  mentions,
+		approvals: approvals,
+		cache:     cache,
+		logger:    logger,
+	}
+}
+
+// recentChangeWindow bounds "recent" for watched-item changes and mentions
+const recentChangeWindow = 7 * 24 * time.Hour
+
+// GetMyWork builds the personalized digest for a user
+func (s *MyWorkService) GetMyWork(ctx context.Context, userID string) (*MyWork, error) {
+	if userID == "" {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	// Try to get from cache first
+	cacheKey := "mywork:" + userID
+	if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != nil {
+		if work, ok := cached.(*MyWork); ok {
+			return work, nil
+		}
+	}
+
+	work := &MyWork{
+		UserID:      userID,
+		GeneratedAt: time.Now().UTC(),
+	}
+	since := work.GeneratedAt.Add(-recentChangeWindow)
+
+	// Open items assigned to the user
+	assigned, _, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+		Assignee: userID,
+		Statuses: []model.ItemStatus{
+			model.ItemStatusNew,
+			model.ItemStatusReady,
+			model.ItemStatusInProgress,
+			model.ItemStatusBlocked,
+		},
+		SortBy: "priority",
+		Limit:  100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	work.AssignedItems = assigned
+
+	// Watched items with recent changes, fetched in one batch
+	watchedIDs, err := s.watchers.GetWatchedItemIDs(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to load watched items", zap.Error(err))
+	} else {
+		for _, itemID := range watchedIDs {
+			item, err := s.backlog.GetItem(ctx, itemID)
+			if err != nil {
+				continue
+			}
+			if item.UpdatedAt.After(since) {
+				work.WatchedChanges = append(work.WatchedChanges, item)
+			}
+		}
+	}
+
+	// Recent comment mentions
+	mentions, err := s.mentions.GetRecentMentions(ctx, userID, since)
+	if err != nil {
+		s.logger.Error("Failed to load mentions", zap.Error(err))
+	} else {
+		work.Mentions = mentions
+	}
+
+	// Approvals waiting on anyone, filtered to those the user requested or
+	// can decide — decision rights live in the API layer, so here we return
+	// all pending and let the caller narrow
+	pending, err := s.approvals.ListPending(ctx)
+	if err != nil {
+		s.logger.Error("Failed to load pending approvals", zap.Error(err))
+	} else {
+		work.PendingApprovals = pending
+	}
+
+	// Cache briefly: the digest is read far more often than it changes
+	if err := s.cache.Set(ctx, cacheKey, work, 1*time.Minute); err != nil {
+		s.logger.Error("Failed to cache my-work digest", zap.Error(err))
+	}
+
+	return work, nil
+}
@@ -0,0 +1,280 @@
+// services/backlog-service/internal/adapters/integration/httpclient/client.go
+
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
+)
+
+// Config holds the shared outbound client settings
+type Config struct {
+	// RequestsPerSecond is the per-host rate limit
+	RequestsPerSecond float64
+
+	// Burst is the per-host burst allowance
+	Burst int
+
+	// MaxRetries caps retry attempts for retryable responses
+	MaxRetries int
+
+	// Timeout is the per-request timeout
+	Timeout time.Duration
+
+	// BreakerThreshold is the consecutive failure count that opens the
+	// circuit for a host
+	BreakerThreshold int
+
+	// BreakerCooldown is how long an open circuit stays open
+	BreakerCooldown time.Duration
+
+	// TokenSource provides OAuth2 tokens with automatic refresh; nil for
+	// unauthenticated or statically authenticated clients
+	TokenSource oauth2.TokenSource
+}
+
+// DefaultConfig is the outbound client config used when fields are unset
+var DefaultConfig = Config{
+	RequestsPerSecond: 5,
+	Burst:             10,
+	MaxRetries:        3,
+	Timeout:           30 * time.Second,
+	BreakerThreshold:  5,
+	BreakerCooldown:   30 * time.Second,
+}
+
+// Prometheus metrics shared by all integration adapters
+var (
+	outboundRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ubmm_outbound_requests_total",
+			Help: "Outbound integration requests by host and status code",
+		},
+		[]string{"host", "code"},
+	)
+	outboundDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ubmm_outbound_request_duration_seconds",
+			Help:    "Outbound integration request duration by host",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"host"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(outboundRequests, outboundDuration)
+}
+
+// breaker is a simple consecutive-failure circuit breaker for one host
+type breaker struct {
+	failures  int
+	openUntil time.Time
+}
+
+// Client is the shared outbound HTTP client used by all integration adapters.
+// It applies per-host rate limiting, retry with Retry-After honoring, circuit
+// breaking, metrics, and OAuth2 token refresh.
+type Client struct {
+	cfg      Config
+	inner    *http.Client
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	breakers map[string]*breaker
+	logger   *zap.Logger
+}
+
+// New creates a new shared outbound client
+func New(cfg Config, logger *zap.Logger) *Client {
+	if cfg.RequestsPerSecond <= 0 {
+		cfg.RequestsPerSecond = DefaultConfig.RequestsPerSecond
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = DefaultConfig.Burst
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = DefaultConfig.MaxRetries
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultConfig.Timeout
+	}
+	if cfg.BreakerThreshold <= 0 {
+		cfg.BreakerThreshold = DefaultConfig.BreakerThreshold
+	}
+	if cfg.BreakerCooldown <= 0 {
+		cfg.BreakerCooldown = DefaultConfig.BreakerCooldown
+	}
+
+	return &Client{
+		cfg:      cfg,
+		inner:    &http.Client{Timeout: cfg.Timeout},
+		limiters: make(map[string]*rate.Limiter),
+		breakers: make(map[string]*breaker),
+		logger:   logger,
+	}
+}
+
+// Do executes a request with the shared protections. The request body must be
+// replayable (GetBody set) for retries to work; requests built with
+// http.NewRequestWithContext from a bytes.Buffer satisfy this.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+
+	// Circuit breaker check
+	if err := c.checkBreaker(host); err != nil {
+		return nil, err
+	}
+
+	// Per-host rate limiting
+	if err := c.limiter(host).Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	// OAuth2 bearer token with automatic refresh
+	if c.cfg.TokenSource != nil {
+		token, err := c.cfg.TokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain OAuth2 token: %w", err)
+		}
+		token.SetAuthHeader(req)
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt <= c.cfg.MaxRetries; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		started := time.Now()
+		resp, err = c.inner.Do(req)
+		outboundDuration.WithLabelValues(host).Observe(time.Since(started).Seconds())
+
+		if err != nil {
+			c.recordFailure(host)
+			outboundRequests.WithLabelValues(host, "error").Inc()
+			if attempt == c.cfg.MaxRetries {
+				return nil, err
+			}
+			if waitErr := c.backoff(req.Context(), attempt, 0); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		outboundRequests.WithLabelValues(host, strconv.Itoa(resp.StatusCode)).Inc()
+
+		// Retry on 429 and 5xx, honoring Retry-After when present
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			c.recordFailure(host)
+			if attempt == c.cfg.MaxRetries {
+				return resp, nil
+			}
+			retryAfter := parseRetryAfter(resp)
+			resp.Body.Close()
+			if waitErr := c.backoff(req.Context(), attempt, retryAfter); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		c.recordSuccess(host)
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// limiter returns (creating if needed) the rate limiter for a host
+func (c *Client) limiter(host string) *rate.Limiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	limiter, ok := c.limiters[host]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(c.cfg.RequestsPerSecond), c.cfg.Burst)
+		c.limiters[host] = limiter
+	}
+	return limiter
+}
+
+// checkBreaker rejects calls while a host's circuit is open
+func (c *Client) checkBreaker(host string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[host]
+	if !ok {
+		return nil
+	}
+	if time.Now().Before(b.openUntil) {
+		return fmt.Errorf("circuit open for host %s", host)
+	}
+	return nil
+}
+
+// recordFailure counts a failure and opens the circuit at the threshold
+func (c *Client) recordFailure(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[host]
+	if !ok {
+		b = &breaker{}
+		c.breakers[host] = b
+	}
+	b.failures++
+	if b.failures >= c.cfg.BreakerThreshold {
+		b.openUntil = time.Now().Add(c.cfg.BreakerCooldown)
+		b.failures = 0
+		c.logger.Warn("Opened outbound circuit breaker",
+			zap.String("host", host),
+			zap.Duration("cooldown", c.cfg.BreakerCooldown))
+	}
+}
+
+// recordSuccess resets the failure counter for a host
+func (c *Client) recordSuccess(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b, ok := c.breakers[host]; ok {
+		b.failures = 0
+	}
+}
+
+// backoff waits before the next retry attempt, preferring the server's
+// Retry-After hint over exponential backoff
+func (c *Client) backoff(ctx context.Context, attempt int, retryAfter time.Duration) error {
+	delay := retryAfter
+	if delay <= 0 {
+		delay = time.Duration(1<<uint(attempt)) * 500 * time.Millisecond
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// parseRetryAfter reads the Retry-After header as seconds
+func parseRetryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
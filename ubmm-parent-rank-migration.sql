@@ -0,0 +1,24 @@
+-- services/backlog-service/migrations/000003_parent_rank.up.sql
+
+-- Dual ranking: priority stays the global backlog rank; parent_rank orders
+-- an item among its siblings under the same parent.
+ALTER TABLE backlog_items ADD COLUMN parent_rank INTEGER NOT NULL DEFAULT 0;
+
+-- Seed sibling ranks from the existing global priorities so current ordering
+-- is preserved within each parent.
+UPDATE backlog_items SET parent_rank = ranked.rank
+FROM (
+    SELECT id, ROW_NUMBER() OVER (
+        PARTITION BY parent_id ORDER BY priority, created_at
+    ) AS rank
+    FROM backlog_items
+    WHERE parent_id IS NOT NULL
+) ranked
+WHERE backlog_items.id = ranked.id;
+
+CREATE INDEX idx_backlog_items_parent_rank ON backlog_items(parent_id, parent_rank);
+
+-- services/backlog-service/migrations/000003_parent_rank.down.sql
+
+DROP INDEX IF EXISTS idx_backlog_items_parent_rank;
+ALTER TABLE backlog_items DROP COLUMN parent_rank;
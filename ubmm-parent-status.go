@@ -0,0 +1,232 @@
+// services/backlog-service/internal/domain/service/parent_status.go
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// ParentStatusRules configures which parent statuses are derived from
+// children; both rules default off so teams opt in per workspace
+type ParentStatusRules struct {
+	// StartParentOnFirstChild moves a NEW/READY parent to IN_PROGRESS when
+	// any child starts
+	StartParentOnFirstChild bool `json:"startParentOnFirstChild"`
+
+	// CompleteParentWhenChildrenDone moves a parent to DONE when every
+	// child is done
+	CompleteParentWhenChildrenDone bool `json:"completeParentWhenChildrenDone"`
+}
+
+// ParentStatusDeriver is the projection that keeps parent statuses in step
+// with their children. It reacts to child status changes and walks up the
+// hierarchy, so a story completing can close its feature and that feature's
+// epic in one pass.
+type ParentStatusDeriver struct {
+	backlog *BacklogService
+	rules   ParentStatusRules
+	logger  *zap.Logger
+}
+
+// NewParentStatusDeriver creates a new parent status deriver
+func NewParentStatusDeriver(backlog *BacklogService, rules ParentStatusRules, logger *zap.Logger) *ParentStatusDeriver {
+	return &ParentStatusDeriver{
+		backlog: backlog,
+		rules:   rules,
+		logger:  logger,
+	}
+}
+
+// DeriveFor re-derives ancestor statuses after a child's status changed. The
+// visited set is the loop protection: a corrupted cyclic hierarchy terminates
+// instead of spinning, and each parent is updated at most once per pass.
+func (d *ParentStatusDeriver) DeriveFor(ctx context.Context, childID uuid.UUID) error {
+	if !d.rules.StartParentOnFirstChild && !d.rules.CompleteParentWhenChildrenDone {
+		return nil
+	}
+
+	current, err := d.backlog.repo.GetByID(ctx, childID)
+	if err != nil {
+		return err
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	for current.ParentID != nil {
+		parentID := *current.ParentID
+		if visited[parentID] {
+			d.logger.Error("Cycle detected in parent chain, stopping derivation",
+				zap.String("itemID", parentID.String()))
+			break
+		}
+		visited[parentID] = true
+
+		parent, err := d.backlog.repo.GetByID(ctx, parentID)
+		if err != nil {
+			return err
+		}
+
+		derived, ok, err := d.derivedStatus(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if !ok || derived == parent.Status {
+			current = parent
+			continue
+		}
+
+		oldStatus := parent.Status
+		if err := parent.UpdateStatus(derived); err != nil {
+			d.logger.Error("Failed to apply derived status",
+				zap.String("itemID", parentID.String()),
+				zap.Error(err))
+			break
+		}
+		if err := d.backlog.repo.Update(ctx, parent); err != nil {
+			return err
+		}
+
+		// Store and publish the derived transition; consumers can tell it
+		// apart from a manual status change
+		derivedEvent := event.NewItemStatusDerivedEvent(parentID, oldStatus, derived, childID)
+		if err := d.backlog.eventRepo.StoreEvent(ctx, derivedEvent); err != nil {
+			d.logger.Error("Failed to store status derived event", zap.Error(err))
+		}
+		if err := d.backlog.eventPublisher.Publish(ctx, "backlog.item.status.derived", derivedEvent); err != nil {
+			d.logger.Error("Failed to publish status derived event", zap.Error(err))
+		}
+
+		// Invalidate caches
+		d.backlog.cache.Delete(ctx, "item:"+parentID.String())
+		d.backlog.invalidateListCache(ctx)
+
+		current = parent
+	}
+
+	return nil
+}
+
+// derivedStatus computes what the rules say a parent's status should be; ok
+// is false when no rule applies
+func (d *ParentStatusDeriver) derivedStatus(ctx context.Context, parent *model.BacklogItem) (model.ItemStatus, bool, error) {
+	children, err := d.backlog.repo.GetChildren(ctx, parent.ID)
+	if err != nil {
+		return "", false, err
+	}
+	if len(children) == 0 {
+		return "", false, nil
+	}
+
+	allDone := true
+	anyStarted := false
+	for _, child := range children {
+		if child.Status != model.ItemStatusDone {
+			allDone = false
+		}
+		if child.Status == model.ItemStatusInProgress || child.Status == model.ItemStatusDone {
+			anyStarted = true
+		}
+	}
+
+	if d.rules.CompleteParentWhenChildrenDone && allDone && parent.Status != model.ItemStatusDone {
+		return model.ItemStatusDone, true, nil
+	}
+
+	startable := parent.Status == model.ItemStatusNew || parent.Status == model.ItemStatusReady
+	if d.rules.StartParentOnFirstChild && anyStarted && startable {
+		return model.ItemStatusInProgress, true, nil
+	}
+
+	return "", false, nil
+}
+
+// services/backlog-service/internal/adapters/eventbus/derived_status_consumer.go
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// derivedStatusTopics are the child lifecycle topics that can change what a
+// parent's status should be. The projection's own derived events are
+// deliberately not consumed: that is the loop protection at the bus level.
+var derivedStatusTopics = []string{
+	"backlog.item.updated",
+	"backlog.item.deleted",
+}
+
+// DerivedStatusConsumer feeds child status changes into the parent status
+// projection
+type DerivedStatusConsumer struct {
+	consumer *KafkaConsumer
+	deriver  *service.ParentStatusDeriver
+	logger   *zap.Logger
+}
+
+// NewDerivedStatusConsumer creates a new derived status consumer. One
+// consumer group is shared across replicas so each event is projected once.
+func NewDerivedStatusConsumer(cfg config.KafkaConfig, deriver *service.ParentStatusDeriver, logger *zap.Logger) (*DerivedStatusConsumer, error) {
+	consumer, err := NewKafkaConsumer(cfg, "backlog-parent-status-projection", logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create derived status consumer: %w", err)
+	}
+	if err := consumer.Subscribe(derivedStatusTopics); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("failed to subscribe to derived status topics: %w", err)
+	}
+
+	return &DerivedStatusConsumer{
+		consumer: consumer,
+		deriver:  deriver,
+		logger:   logger,
+	}, nil
+}
+
+// derivedStatusPayload is the slice of the event payload the consumer reads
+type derivedStatusPayload struct {
+	ItemID string `json:"itemId"`
+}
+
+// Start consumes child change events until the context is cancelled
+func (c *DerivedStatusConsumer) Start(ctx context.Context) error {
+	return c.consumer.ConsumeMessages(ctx, func(message *kafka.Message) error {
+		var payload derivedStatusPayload
+		if err := json.Unmarshal(message.Value, &payload); err != nil {
+			c.logger.Error("Failed to parse derived status event", zap.Error(err))
+			return nil
+		}
+
+		itemID, err := uuid.Parse(payload.ItemID)
+		if err != nil {
+			return nil
+		}
+
+		if err := c.deriver.DeriveFor(ctx, itemID); err != nil {
+			// Derivation is best-effort; the next child event retries it
+			c.logger.Error("Failed to derive parent status",
+				zap.String("itemID", payload.ItemID),
+				zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close shuts the consumer down
+func (c *DerivedStatusConsumer) Close() error {
+	return c.consumer.Close()
+}
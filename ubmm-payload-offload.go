@@ -0,0 +1,178 @@
+// services/backlog-service/internal/domain/event/offload.go
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DefaultMaxPayloadBytes is the payload size above which the publisher
+// offloads large fields. Kept well under the broker's 1 MiB default message
+// limit so headers and envelope overhead never push a message over it.
+const DefaultMaxPayloadBytes = 256 * 1024
+
+// offloadRefField marks a field value that was moved to object storage; the
+// value is the storage key. The client SDK rehydrates these transparently.
+const offloadRefField = "$offloadRef"
+
+// ObjectStore persists offloaded payload fields. Implementations live in the
+// adapters layer (S3, GCS, filesystem for development).
+type ObjectStore interface {
+	// Put stores data under key
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get retrieves the data stored under key
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// OffloadingPublisher wraps a Publisher with a payload size guard: events
+// over the limit have their largest top-level fields moved to object storage
+// and replaced with reference markers, so the bus only ever carries a
+// bounded envelope.
+type OffloadingPublisher struct {
+	inner    Publisher
+	store    ObjectStore
+	maxBytes int
+	logger   *zap.Logger
+}
+
+// NewOffloadingPublisher creates a new offloading publisher. maxBytes <= 0
+// selects DefaultMaxPayloadBytes.
+func NewOffloadingPublisher(inner Publisher, store ObjectStore, maxBytes int, logger *zap.Logger) *OffloadingPublisher {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxPayloadBytes
+	}
+	return &OffloadingPublisher{
+		inner:    inner,
+		store:    store,
+		maxBytes: maxBytes,
+		logger:   logger,
+	}
+}
+
+// Publish forwards events under the size limit unchanged and offloads the
+// largest fields of oversized events before publishing the envelope
+func (p *OffloadingPublisher) Publish(ctx context.Context, topic string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if len(payload) <= p.maxBytes {
+		return p.inner.Publish(ctx, topic, event)
+	}
+
+	envelope, err := p.offloadFields(ctx, topic, payload)
+	if err != nil {
+		return fmt.Errorf("failed to offload oversized event payload: %w", err)
+	}
+	return p.inner.Publish(ctx, topic, json.RawMessage(envelope))
+}
+
+// offloadFields moves top-level fields to object storage, largest first,
+// until the envelope fits under the limit
+func (p *OffloadingPublisher) offloadFields(ctx context.Context, topic string, payload []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, fmt.Errorf("oversized payload is not a JSON object: %w", err)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return len(fields[names[i]]) > len(fields[names[j]])
+	})
+
+	size := len(payload)
+	eventID := uuid.New()
+	for _, name := range names {
+		if size <= p.maxBytes {
+			break
+		}
+
+		key := fmt.Sprintf("events/%s/%s/%s", topic, eventID, name)
+		if err := p.store.Put(ctx, key, fields[name]); err != nil {
+			return nil, err
+		}
+
+		ref, err := json.Marshal(map[string]string{offloadRefField: key})
+		if err != nil {
+			return nil, err
+		}
+		size -= len(fields[name]) - len(ref)
+		fields[name] = ref
+
+		p.logger.Info("Offloaded oversized event field",
+			zap.String("topic", topic),
+			zap.String("field", name),
+			zap.String("key", key))
+	}
+
+	return json.Marshal(fields)
+}
+
+// services/backlog-service/pkg/client/offload.go
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// offloadRefField mirrors the marker the publisher uses for fields moved to
+// object storage
+const offloadRefField = "$offloadRef"
+
+// ObjectFetcher retrieves offloaded payload fields from object storage
+type ObjectFetcher interface {
+	// Get retrieves the data stored under key
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// Rehydrate replaces offload references in an event payload with the stored
+// field content and returns the restored payload. Payloads without
+// references are returned unchanged, so consumers can call it on every
+// event.
+func Rehydrate(ctx context.Context, fetcher ObjectFetcher, payload []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return payload, nil
+	}
+
+	changed := false
+	for name, value := range fields {
+		key, ok := offloadRef(value)
+		if !ok {
+			continue
+		}
+		data, err := fetcher.Get(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rehydrate field %q: %w", name, err)
+		}
+		fields[name] = data
+		changed = true
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(fields)
+}
+
+// offloadRef returns the storage key when value is an offload reference
+func offloadRef(value json.RawMessage) (string, bool) {
+	var ref map[string]string
+	if err := json.Unmarshal(value, &ref); err != nil {
+		return "", false
+	}
+	key, ok := ref[offloadRefField]
+	return key, ok && len(ref) == 1
+}
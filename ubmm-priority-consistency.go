@@ -0,0 +1,202 @@
+// services/backlog-service/internal/domain/service/priority_consistency.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+const (
+	// priorityDriftThreshold is the normalized backlog-position gap between
+	// an epic and the median of its children above which the pair is flagged.
+	// 0.4 means the children sit almost half the backlog away from the epic.
+	priorityDriftThreshold = 0.4
+
+	// priorityScanPageSize is how many items each scan page loads
+	priorityScanPageSize = 200
+)
+
+// PriorityInconsistency is one epic whose children are ranked far from the
+// epic itself. Drift is the children's median normalized position minus the
+// epic's: positive means a high-priority epic with buried children, negative
+// means a low-priority epic whose children crowd the top of the backlog.
+type PriorityInconsistency struct {
+	Epic             *model.BacklogItem `json:"epic"`
+	Children         int                `json:"children"`
+	EpicPosition     float64            `json:"epicPosition"`
+	ChildrenPosition float64            `json:"childrenPosition"`
+	Drift            float64            `json:"drift"`
+}
+
+// PriorityConsistencyService flags epics whose global rank and children's
+// ranks disagree, and can nudge the children back toward the epic's band
+type PriorityConsistencyService struct {
+	backlog *BacklogService
+	logger  *zap.Logger
+}
+
+// NewPriorityConsistencyService creates a new priority consistency service
+func NewPriorityConsistencyService(backlog *BacklogService, logger *zap.Logger) *PriorityConsistencyService {
+	return &PriorityConsistencyService{
+		backlog: backlog,
+		logger:  logger,
+	}
+}
+
+// GetConsistencyReport scans the open backlog and reports epics whose
+// children's median position drifts from the epic's own position by more
+// than the threshold, worst drift first. Positions are normalized to 0 (top
+// of the backlog) through 1 (bottom) so the report is stable as the backlog
+// grows.
+func (s *PriorityConsistencyService) GetConsistencyReport(ctx context.Context) ([]*PriorityInconsistency, error) {
+	items, err := s.listOpenByPriority(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) < 2 {
+		return nil, nil
+	}
+
+	// Normalized backlog position per item, in priority order
+	positions := make(map[uuid.UUID]float64, len(items))
+	for i, item := range items {
+		positions[item.ID] = float64(i) / float64(len(items)-1)
+	}
+
+	// Children's positions grouped under their parent
+	childPositions := make(map[uuid.UUID][]float64)
+	for _, item := range items {
+		if item.ParentID != nil {
+			childPositions[*item.ParentID] = append(childPositions[*item.ParentID], positions[item.ID])
+		}
+	}
+
+	var report []*PriorityInconsistency
+	for _, item := range items {
+		if item.Type != model.ItemTypeEpic {
+			continue
+		}
+		children := childPositions[item.ID]
+		if len(children) == 0 {
+			continue
+		}
+
+		drift := median(children) - positions[item.ID]
+		if math.Abs(drift) < priorityDriftThreshold {
+			continue
+		}
+
+		report = append(report, &PriorityInconsistency{
+			Epic:             item,
+			Children:         len(children),
+			EpicPosition:     positions[item.ID],
+			ChildrenPosition: median(children),
+			Drift:            drift,
+		})
+	}
+
+	// Worst drift first, so the most misleading epics lead the report
+	sort.Slice(report, func(i, j int) bool {
+		return math.Abs(report[i].Drift) > math.Abs(report[j].Drift)
+	})
+
+	return report, nil
+}
+
+// NudgeChildren moves an epic's open children into the priority band just
+// below the epic, keeping their relative order. The nudge is optional
+// automation driven from the report; it never runs on its own.
+func (s *PriorityConsistencyService) NudgeChildren(ctx context.Context, epicID uuid.UUID) (int, error) {
+	epic, err := s.backlog.GetItem(ctx, epicID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get epic: %w", err)
+	}
+	if epic.Type != model.ItemTypeEpic {
+		return 0, fmt.Errorf("item %s is not an epic", epicID)
+	}
+
+	children, err := s.backlog.repo.GetChildren(ctx, epicID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get children: %w", err)
+	}
+
+	var open []*model.BacklogItem
+	for _, child := range children {
+		if child.Status == model.ItemStatusDone || child.Status == model.ItemStatusRejected {
+			continue
+		}
+		open = append(open, child)
+	}
+	if len(open) == 0 {
+		return 0, nil
+	}
+
+	// Keep the children's relative order while pulling them into the band
+	sort.Slice(open, func(i, j int) bool { return open[i].Priority < open[j].Priority })
+
+	reorders := make([]ReorderRequest, 0, len(open))
+	for i, child := range open {
+		reorders = append(reorders, ReorderRequest{
+			ItemID:      child.ID,
+			NewPriority: epic.Priority + i + 1,
+		})
+	}
+
+	if err := s.backlog.ReorderItems(ctx, reorders); err != nil {
+		return 0, fmt.Errorf("failed to reorder children: %w", err)
+	}
+
+	s.logger.Info("Nudged children into epic's priority band",
+		zap.String("epicId", epicID.String()),
+		zap.Int("children", len(reorders)))
+
+	return len(reorders), nil
+}
+
+// listOpenByPriority pages through every open item in priority order
+func (s *PriorityConsistencyService) listOpenByPriority(ctx context.Context) ([]*model.BacklogItem, error) {
+	var items []*model.BacklogItem
+	offset := 0
+	for {
+		page, _, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+			Statuses: []model.ItemStatus{
+				model.ItemStatusIntake,
+				model.ItemStatusNew,
+				model.ItemStatusReady,
+				model.ItemStatusInProgress,
+				model.ItemStatusBlocked,
+			},
+			SortBy: "priority",
+			Limit:  priorityScanPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list items: %w", err)
+		}
+		items = append(items, page...)
+		if len(page) < priorityScanPageSize {
+			return items, nil
+		}
+		offset += priorityScanPageSize
+	}
+}
+
+// median returns the middle value of a non-empty slice
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,252 @@
+// services/backlog-service/internal/domain/projection/projection.go
+
+package projection
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/replay"
+)
+
+// Projection is a named read model built from domain events. Implementations
+// register one handler per event type they care about and must be idempotent
+// so the projection can be rebuilt from a replay.
+type Projection interface {
+	// Name returns the unique projection name, used for checkpointing
+	Name() string
+
+	// Handlers returns the event handlers keyed by event type
+	Handlers() map[event.EventType]replay.Handler
+
+	// Reset clears the projection's state ahead of a rebuild
+	Reset(ctx context.Context) error
+}
+
+// Manager dispatches events to registered projections and tracks per-projection
+// checkpoints so each read model can catch up or rebuild independently.
+type Manager struct {
+	projections map[string]Projection
+	replayer    *replay.Replayer
+	logger      *zap.Logger
+}
+
+// NewManager creates a new projection manager
+func NewManager(replayer *replay.Replayer, logger *zap.Logger) *Manager {
+	return &Manager{
+		projections: make(map[string]Projection),
+		replayer:    replayer,
+		logger:      logger,
+	}
+}
+
+// Register adds a projection to the manager
+func (m *Manager) Register(p Projection) error {
+	if _, exists := m.projections[p.Name()]; exists {
+		return fmt.Errorf("projection %q already registered", p.Name())
+	}
+	m.projections[p.Name()] = p
+	return nil
+}
+
+// Apply dispatches a live event to every projection that handles its type
+func (m *Manager) Apply(ctx context.Context, evt *replay.StoredEvent) error {
+	for name, p := range m.projections {
+		handler, ok := p.Handlers()[evt.Type]
+		if !ok {
+			continue
+		}
+		if err := handler(ctx, evt); err != nil {
+			return fmt.Errorf("projection %q failed on event %s: %w", name, evt.ID, err)
+		}
+	}
+	return nil
+}
+
+// Rebuild resets a projection and replays all matching historical events into
+// it, checkpointed under the projection's name so the run can resume.
+func (m *Manager) Rebuild(ctx context.Context, name string) error {
+	p, ok := m.projections[name]
+	if !ok {
+		return fmt.Errorf("projection %q not registered", name)
+	}
+
+	if err := p.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset projection %q: %w", name, err)
+	}
+
+	// Restrict the replay to the event types this projection handles
+	handlers := p.Handlers()
+	types := make([]event.EventType, 0, len(handlers))
+	for t := range handlers {
+		types = append(types, t)
+	}
+
+	processed, err := m.replayer.ReplayToHandler(ctx,
+		func(ctx context.Context, evt *replay.StoredEvent) error {
+			handler, ok := handlers[evt.Type]
+			if !ok {
+				return nil
+			}
+			return handler(ctx, evt)
+		},
+		replay.Filter{Types: types},
+		replay.Options{RunID: "projection:" + name},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild projection %q: %w", name, err)
+	}
+
+	m.logger.Info("Projection rebuilt",
+		zap.String("projection", name),
+		zap.Int("events", processed))
+
+	return nil
+}
+
+// services/backlog-service/internal/domain/projection/item_search.go
+
+package projection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/replay"
+)
+
+// ItemSearchRow is the denormalized read model row for fast list queries
+type ItemSearchRow struct {
+	ItemID      uuid.UUID        `json:"itemId"`
+	Type        model.ItemType   `json:"type"`
+	Title       string           `json:"title"`
+	Status      model.ItemStatus `json:"status"`
+	Priority    int              `json:"priority"`
+	ParentID    *uuid.UUID       `json:"parentId"`
+	ParentTitle string           `json:"parentTitle"`
+	Tags        []string         `json:"tags"`
+	ChildCount  int              `json:"childCount"`
+	ChildPoints int              `json:"childPoints"`
+}
+
+// ItemSearchStore persists the item_search read model
+type ItemSearchStore interface {
+	// Upsert inserts or replaces a read model row
+	Upsert(ctx context.Context, row *ItemSearchRow) error
+
+	// Delete removes a read model row
+	Delete(ctx context.Context, itemID uuid.UUID) error
+
+	// UpdateParentTitle refreshes the denormalized parent title on all children
+	UpdateParentTitle(ctx context.Context, parentID uuid.UUID, title string) error
+
+	// UpdateRollups refreshes child count and point rollups on a parent row
+	UpdateRollups(ctx context.Context, parentID uuid.UUID) error
+
+	// Truncate clears the read model ahead of a rebuild
+	Truncate(ctx context.Context) error
+}
+
+// ItemSearchProjection maintains the item_search read model keeping
+// denormalized parent titles, tag arrays, and rollups
+type ItemSearchProjection struct {
+	store  ItemSearchStore
+	logger *zap.Logger
+}
+
+// NewItemSearchProjection creates a new item search projection
+func NewItemSearchProjection(store ItemSearchStore, logger *zap.Logger) *ItemSearchProjection {
+	return &ItemSearchProjection{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Name returns the projection name
+func (p *ItemSearchProjection) Name() string {
+	return "item_search"
+}
+
+// Reset clears the read model
+func (p *ItemSearchProjection) Reset(ctx context.Context) error {
+	return p.store.Truncate(ctx)
+}
+
+// Handlers returns the event handlers for the projection
+func (p *ItemSearchProjection) Handlers() map[event.EventType]replay.Handler {
+	return map[event.EventType]replay.Handler{
+		event.EventTypeItemCreated: p.handleItemUpserted,
+		event.EventTypeItemUpdated: p.handleItemUpserted,
+		event.EventTypeItemDeleted: p.handleItemDeleted,
+	}
+}
+
+// handleItemUpserted applies created/updated events to the read model
+func (p *ItemSearchProjection) handleItemUpserted(ctx context.Context, evt *replay.StoredEvent) error {
+	var payload struct {
+		Item *model.BacklogItem `json:"item"`
+	}
+	if err := json.Unmarshal(evt.Payload, &payload); err != nil {
+		return err
+	}
+	if payload.Item == nil {
+		return nil
+	}
+	item := payload.Item
+
+	row := &ItemSearchRow{
+		ItemID:   item.ID,
+		Type:     item.Type,
+		Title:    item.Title,
+		Status:   item.Status,
+		Priority: item.Priority,
+		ParentID: item.ParentID,
+		Tags:     item.Tags,
+	}
+	if err := p.store.Upsert(ctx, row); err != nil {
+		return err
+	}
+
+	// Keep denormalized copies in sync: the item's title on its children,
+	// and the rollups on its parent
+	if err := p.store.UpdateParentTitle(ctx, item.ID, item.Title); err != nil {
+		return err
+	}
+	if item.ParentID != nil {
+		if err := p.store.UpdateRollups(ctx, *item.ParentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleItemDeleted removes the row and refreshes parent rollups
+func (p *ItemSearchProjection) handleItemDeleted(ctx context.Context, evt *replay.StoredEvent) error {
+	var payload struct {
+		ItemID uuid.UUID          `json:"itemId"`
+		Item   *model.BacklogItem `json:"item"`
+	}
+	if err := json.Unmarshal(evt.Payload, &payload); err != nil {
+		return err
+	}
+
+	if err := p.store.Delete(ctx, payload.ItemID); err != nil {
+		return err
+	}
+	if payload.Item != nil && payload.Item.ParentID != nil {
+		if err := p.store.UpdateRollups(ctx, *payload.Item.ParentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
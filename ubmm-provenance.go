@@ -0,0 +1,37 @@
+// services/backlog-service/internal/domain/service/provenance.go
+
+package service
+
+import (
+	"context"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// Context keys for item provenance. Adapters populate these so CreateItem can
+// record who filed an item and through which channel without every call site
+// threading the values explicitly.
+type contextKey string
+
+const (
+	reporterContextKey contextKey = "ubmm.reporter"
+	sourceContextKey   contextKey = "ubmm.source"
+)
+
+// WithProvenance returns a context carrying the reporter and source for
+// items created during the request
+func WithProvenance(ctx context.Context, reporter string, source model.ItemSource) context.Context {
+	ctx = context.WithValue(ctx, reporterContextKey, reporter)
+	return context.WithValue(ctx, sourceContextKey, source)
+}
+
+// ProvenanceFromContext extracts the reporter and source from the context.
+// Missing values default to an empty reporter and the API source.
+func ProvenanceFromContext(ctx context.Context) (string, model.ItemSource) {
+	reporter, _ := ctx.Value(reporterContextKey).(string)
+	source, ok := ctx.Value(sourceContextKey).(model.ItemSource)
+	if !ok || source == "" {
+		source = model.ItemSourceAPI
+	}
+	return reporter, source
+}
@@ -0,0 +1,178 @@
+// services/backlog-service/internal/domain/service/quotas.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// Quota names used in limits, usage reports and warning events
+const (
+	QuotaMaxItems           = "max_items"
+	QuotaMaxAttachmentBytes = "max_attachment_bytes"
+	QuotaMaxWebhooks        = "max_webhooks"
+	QuotaAPIRequestsPerDay  = "api_requests_per_day"
+)
+
+// QuotaLimits holds the configured soft limits for one workspace; a zero
+// value means unlimited
+type QuotaLimits struct {
+	MaxItems           int64 `json:"maxItems"`
+	MaxAttachmentBytes int64 `json:"maxAttachmentBytes"`
+	MaxWebhooks        int64 `json:"maxWebhooks"`
+	APIRequestsPerDay  int64 `json:"apiRequestsPerDay"`
+}
+
+// QuotaUsage is one quota's current consumption against its limit
+type QuotaUsage struct {
+	Quota   string  `json:"quota"`
+	Used    int64   `json:"used"`
+	Limit   int64   `json:"limit"`
+	Percent float64 `json:"percent"`
+}
+
+// QuotaStore persists limits and usage counters per workspace
+type QuotaStore interface {
+	// GetLimits retrieves the configured limits for a workspace
+	GetLimits(ctx context.Context, workspaceID uuid.UUID) (*QuotaLimits, error)
+
+	// SetLimits stores the limits for a workspace
+	SetLimits(ctx context.Context, workspaceID uuid.UUID, limits *QuotaLimits) error
+
+	// GetUsage retrieves the current usage of a quota
+	GetUsage(ctx context.Context, workspaceID uuid.UUID, quota string) (int64, error)
+
+	// AddUsage atomically increments a usage counter and returns the new value
+	AddUsage(ctx context.Context, workspaceID uuid.UUID, quota string, delta int64) (int64, error)
+}
+
+// ErrQuotaExceeded is returned by Consume when a workspace hits a hard limit
+type ErrQuotaExceeded struct {
+	Quota string
+	Limit int64
+}
+
+// Error implements the error interface
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota %q exceeded (limit %d)", e.Quota, e.Limit)
+}
+
+// QuotaService enforces soft plan limits per workspace and emits warning
+// events as thresholds approach
+type QuotaService struct {
+	store            QuotaStore
+	eventPublisher   event.Publisher
+	warningThreshold float64
+	logger           *zap.Logger
+}
+
+// NewQuotaService creates a new quota service. warningThreshold is the usage
+// fraction (e.g. 0.8) at which warning events fire.
+func NewQuotaService(store QuotaStore, eventPublisher event.Publisher, warningThreshold float64, logger *zap.Logger) *QuotaService {
+	if warningThreshold <= 0 || warningThreshold >= 1 {
+		warningThreshold = 0.8
+	}
+	return &QuotaService{
+		store:            store,
+		eventPublisher:   eventPublisher,
+		warningThreshold: warningThreshold,
+		logger:           logger,
+	}
+}
+
+// Consume checks and records quota consumption. It returns ErrQuotaExceeded
+// when the delta would push usage past the workspace's limit.
+func (s *QuotaService) Consume(ctx context.Context, workspaceID uuid.UUID, quota string, delta int64) error {
+	limits, err := s.store.GetLimits(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to load quota limits: %w", err)
+	}
+
+	limit := limitFor(limits, quota)
+	if limit <= 0 {
+		// Unlimited: still track usage for reporting
+		if _, err := s.store.AddUsage(ctx, workspaceID, quota, delta); err != nil {
+			s.logger.Error("Failed to record quota usage", zap.Error(err))
+		}
+		return nil
+	}
+
+	used, err := s.store.GetUsage(ctx, workspaceID, quota)
+	if err != nil {
+		return fmt.Errorf("failed to load quota usage: %w", err)
+	}
+	if used+delta > limit {
+		return &ErrQuotaExceeded{Quota: quota, Limit: limit}
+	}
+
+	newUsed, err := s.store.AddUsage(ctx, workspaceID, quota, delta)
+	if err != nil {
+		return fmt.Errorf("failed to record quota usage: %w", err)
+	}
+
+	// Emit a warning event when crossing the threshold
+	before := float64(used) / float64(limit)
+	after := float64(newUsed) / float64(limit)
+	if before < s.warningThreshold && after >= s.warningThreshold {
+		warningEvent := event.NewQuotaWarningEvent(workspaceID, quota, newUsed, limit)
+		if err := s.eventPublisher.Publish(ctx, "backlog.workspace.quota.warning", warningEvent); err != nil {
+			s.logger.Error("Failed to publish quota warning event", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// GetUsageReport returns the usage of every quota for a workspace
+func (s *QuotaService) GetUsageReport(ctx context.Context, workspaceID uuid.UUID) ([]*QuotaUsage, error) {
+	limits, err := s.store.GetLimits(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load quota limits: %w", err)
+	}
+
+	var report []*QuotaUsage
+	for _, quota := range []string{QuotaMaxItems, QuotaMaxAttachmentBytes, QuotaMaxWebhooks, QuotaAPIRequestsPerDay} {
+		used, err := s.store.GetUsage(ctx, workspaceID, quota)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load usage for %s: %w", quota, err)
+		}
+		usage := &QuotaUsage{
+			Quota: quota,
+			Used:  used,
+			Limit: limitFor(limits, quota),
+		}
+		if usage.Limit > 0 {
+			usage.Percent = float64(used) / float64(usage.Limit) * 100
+		}
+		report = append(report, usage)
+	}
+
+	return report, nil
+}
+
+// SetLimits updates a workspace's quota limits
+func (s *QuotaService) SetLimits(ctx context.Context, workspaceID uuid.UUID, limits *QuotaLimits) error {
+	return s.store.SetLimits(ctx, workspaceID, limits)
+}
+
+// limitFor maps a quota name to its configured limit
+func limitFor(limits *QuotaLimits, quota string) int64 {
+	switch quota {
+	case QuotaMaxItems:
+		return limits.MaxItems
+	case QuotaMaxAttachmentBytes:
+		return limits.MaxAttachmentBytes
+	case QuotaMaxWebhooks:
+		return limits.MaxWebhooks
+	case QuotaAPIRequestsPerDay:
+		return limits.APIRequestsPerDay
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,190 @@
+// services/backlog-service/internal/domain/service/recurring_items.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+)
+
+// RecurringItemDefinition is a template plus schedule from which items are
+// generated on a recurring basis (e.g. a monthly compliance story)
+type RecurringItemDefinition struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        model.ItemType   `json:"type"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	ParentID    *uuid.UUID       `json:"parentId"`
+	StoryPoints int              `json:"storyPoints"`
+	Tags        []string         `json:"tags"`
+	Assignee    string           `json:"assignee"`
+
+	// CronSchedule is a standard 5-field cron expression
+	CronSchedule string `json:"cronSchedule"`
+
+	// SkipIfOpen skips a scheduled instantiation while the previous
+	// instance is not yet done
+	SkipIfOpen bool `json:"skipIfOpen"`
+
+	Enabled        bool       `json:"enabled"`
+	LastGeneratedID *uuid.UUID `json:"lastGeneratedId"`
+	NextRunAt      time.Time  `json:"nextRunAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// RecurringItemRepository defines the interface for definition persistence
+type RecurringItemRepository interface {
+	// Create stores a new recurring item definition
+	Create(ctx context.Context, def *RecurringItemDefinition) error
+
+	// GetByID retrieves a definition by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*RecurringItemDefinition, error)
+
+	// List retrieves all definitions
+	List(ctx context.Context) ([]*RecurringItemDefinition, error)
+
+	// ListDue retrieves enabled definitions whose next run is at or before now
+	ListDue(ctx context.Context, now time.Time) ([]*RecurringItemDefinition, error)
+
+	// Update updates an existing definition
+	Update(ctx context.Context, def *RecurringItemDefinition) error
+
+	// Delete removes a definition
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RecurringItemService manages definitions and instantiates items on schedule
+type RecurringItemService struct {
+	defs    RecurringItemRepository
+	backlog *BacklogService
+	parser  cron.Parser
+	logger  *zap.Logger
+}
+
+// NewRecurringItemService creates a new recurring item service
+func NewRecurringItemService(defs RecurringItemRepository, backlog *BacklogService, logger *zap.Logger) *RecurringItemService {
+	return &RecurringItemService{
+		defs:    defs,
+		backlog: backlog,
+		parser:  cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow),
+		logger:  logger,
+	}
+}
+
+// CreateDefinition validates and stores a new recurring item definition
+func (s *RecurringItemService) CreateDefinition(ctx context.Context, def *RecurringItemDefinition) error {
+	if def.Title == "" {
+		return errors.New("title cannot be empty")
+	}
+	schedule, err := s.parser.Parse(def.CronSchedule)
+	if err != nil {
+		return fmt.Errorf("invalid cron schedule: %w", err)
+	}
+
+	now := time.Now().UTC()
+	def.ID = uuid.New()
+	def.Enabled = true
+	def.NextRunAt = schedule.Next(now)
+	def.CreatedAt = now
+	def.UpdatedAt = now
+
+	return s.defs.Create(ctx, def)
+}
+
+// UpdateDefinition validates and stores changes to a definition
+func (s *RecurringItemService) UpdateDefinition(ctx context.Context, def *RecurringItemDefinition) error {
+	schedule, err := s.parser.Parse(def.CronSchedule)
+	if err != nil {
+		return fmt.Errorf("invalid cron schedule: %w", err)
+	}
+	def.NextRunAt = schedule.Next(time.Now().UTC())
+	def.UpdatedAt = time.Now().UTC()
+	return s.defs.Update(ctx, def)
+}
+
+// ListDefinitions retrieves all recurring item definitions
+func (s *RecurringItemService) ListDefinitions(ctx context.Context) ([]*RecurringItemDefinition, error) {
+	return s.defs.List(ctx)
+}
+
+// DeleteDefinition removes a definition
+func (s *RecurringItemService) DeleteDefinition(ctx context.Context, id uuid.UUID) error {
+	return s.defs.Delete(ctx, id)
+}
+
+// RunDue instantiates items for all definitions whose schedule has elapsed.
+// It is intended to be called periodically by the scheduler.
+func (s *RecurringItemService) RunDue(ctx context.Context) error {
+	now := time.Now().UTC()
+	due, err := s.defs.ListDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, def := range due {
+		if err := s.instantiate(ctx, def, now); err != nil {
+			s.logger.Error("Failed to instantiate recurring item",
+				zap.String("definitionId", def.ID.String()),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// instantiate creates one item from a definition and advances its schedule
+func (s *RecurringItemService) instantiate(ctx context.Context, def *RecurringItemDefinition, now time.Time) error {
+	// Optionally skip while the previous instance is still open
+	if def.SkipIfOpen && def.LastGeneratedID != nil {
+		prev, err := s.backlog.GetItem(ctx, *def.LastGeneratedID)
+		if err == nil && prev != nil && prev.Status != model.ItemStatusDone {
+			s.logger.Info("Skipping recurring item, previous instance still open",
+				zap.String("definitionId", def.ID.String()),
+				zap.String("previousItemId", prev.ID.String()))
+			return s.advanceSchedule(ctx, def, now)
+		}
+	}
+
+	item, err := s.backlog.CreateItem(ctx, &CreateItemRequest{
+		Type:        def.Type,
+		Title:       def.Title,
+		Description: def.Description,
+		ParentID:    def.ParentID,
+		StoryPoints: def.StoryPoints,
+		Tags:        def.Tags,
+		Assignee:    def.Assignee,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Link the instance back to its definition for traceability
+	if err := s.backlog.SetExternalID(ctx, item.ID, "recurring", def.ID.String()); err != nil {
+		s.logger.Error("Failed to link recurring instance to definition",
+			zap.String("itemId", item.ID.String()),
+			zap.Error(err))
+	}
+
+	def.LastGeneratedID = &item.ID
+	return s.advanceSchedule(ctx, def, now)
+}
+
+// advanceSchedule computes and stores the next run time
+func (s *RecurringItemService) advanceSchedule(ctx context.Context, def *RecurringItemDefinition, now time.Time) error {
+	schedule, err := s.parser.Parse(def.CronSchedule)
+	if err != nil {
+		return fmt.Errorf("invalid cron schedule: %w", err)
+	}
+	def.NextRunAt = schedule.Next(now)
+	def.UpdatedAt = now
+	return s.defs.Update(ctx, def)
+}
@@ -0,0 +1,144 @@
+// services/backlog-service/internal/domain/service/refinement_report.go
+
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// minRefinedDescriptionLength is the description length below which a story
+// counts as under-described in the refinement report
+const minRefinedDescriptionLength = 140
+
+// Refinement gap reasons, stable strings so clients can filter on them
+const (
+	RefinementGapMissingPoints        = "MISSING_POINTS"
+	RefinementGapShortDescription     = "SHORT_DESCRIPTION"
+	RefinementGapNoAcceptanceCriteria = "NO_ACCEPTANCE_CRITERIA"
+)
+
+// RefinementGap is one unrefined story and what it is missing
+type RefinementGap struct {
+	Item    *model.BacklogItem `json:"item"`
+	Reasons []string           `json:"reasons"`
+}
+
+// EpicRefinementGroup collects a root epic's unrefined stories
+type EpicRefinementGroup struct {
+	EpicID    *uuid.UUID       `json:"epicId,omitempty"` // nil for stories outside any epic
+	EpicTitle string           `json:"epicTitle"`
+	Gaps      []*RefinementGap `json:"gaps"`
+}
+
+// RefinementReport lists the unrefined work in the backlog, grouped by epic
+type RefinementReport struct {
+	Groups              []*EpicRefinementGroup `json:"groups"`
+	TotalStories        int                    `json:"totalStories"`
+	UnrefinedCount      int                    `json:"unrefinedCount"`
+	UnrefinedPercentage float64                `json:"unrefinedPercentage"`
+}
+
+// GetRefinementReport lists NEW and READY stories that are missing estimates,
+// under-described, or lacking an acceptance criteria section, grouped by root
+// epic. The unrefined percentage feeds backlog health.
+func (s *BacklogService) GetRefinementReport(ctx context.Context) (*RefinementReport, error) {
+	report := &RefinementReport{}
+	groups := make(map[string]*EpicRefinementGroup)
+
+	offset := 0
+	for {
+		stories, total, err := s.repo.List(ctx, repository.BacklogFilter{
+			Types:    []model.ItemType{model.ItemTypeStory},
+			Statuses: []model.ItemStatus{model.ItemStatusNew, model.ItemStatusReady},
+			Limit:    200,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, story := range stories {
+			report.TotalStories++
+
+			reasons := refinementGaps(story)
+			if len(reasons) == 0 {
+				continue
+			}
+			report.UnrefinedCount++
+
+			epicID, epicTitle, err := s.rootEpicOf(ctx, story)
+			if err != nil {
+				return nil, err
+			}
+
+			groupKey := ""
+			if epicID != nil {
+				groupKey = epicID.String()
+			}
+			group, ok := groups[groupKey]
+			if !ok {
+				group = &EpicRefinementGroup{EpicID: epicID, EpicTitle: epicTitle}
+				groups[groupKey] = group
+				report.Groups = append(report.Groups, group)
+			}
+			group.Gaps = append(group.Gaps, &RefinementGap{Item: story, Reasons: reasons})
+		}
+
+		offset += len(stories)
+		if int64(offset) >= total || len(stories) == 0 {
+			break
+		}
+	}
+
+	if report.TotalStories > 0 {
+		report.UnrefinedPercentage = float64(report.UnrefinedCount) / float64(report.TotalStories) * 100
+	}
+
+	// Largest gap groups first
+	sort.Slice(report.Groups, func(i, j int) bool {
+		return len(report.Groups[i].Gaps) > len(report.Groups[j].Gaps)
+	})
+
+	return report, nil
+}
+
+// refinementGaps returns what a story is missing before it is ready to work
+func refinementGaps(story *model.BacklogItem) []string {
+	var reasons []string
+	if story.StoryPoints == 0 {
+		reasons = append(reasons, RefinementGapMissingPoints)
+	}
+	if len(story.Description) < minRefinedDescriptionLength {
+		reasons = append(reasons, RefinementGapShortDescription)
+	}
+	if !strings.Contains(strings.ToLower(story.Description), "acceptance criteria") {
+		reasons = append(reasons, RefinementGapNoAcceptanceCriteria)
+	}
+	return reasons
+}
+
+// rootEpicOf resolves the epic at the top of a story's ancestor chain
+func (s *BacklogService) rootEpicOf(ctx context.Context, story *model.BacklogItem) (*uuid.UUID, string, error) {
+	if story.ParentID == nil {
+		return nil, "No epic", nil
+	}
+
+	ancestors, err := s.GetAncestors(ctx, story.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.Type == model.ItemTypeEpic {
+			id := ancestor.ID
+			return &id, ancestor.Title, nil
+		}
+	}
+	return nil, "No epic", nil
+}
@@ -0,0 +1,17 @@
+-- services/backlog-service/migrations/000013_retention_policies.up.sql
+
+-- Per-workspace data retention policies. A zero means keep forever; each
+-- category is purged by the scheduled retention job once rows age past the
+-- configured number of days.
+CREATE TABLE IF NOT EXISTS retention_policies (
+    workspace_id UUID PRIMARY KEY,
+    events_days INT NOT NULL DEFAULT 0,
+    audit_days INT NOT NULL DEFAULT 0,
+    activity_days INT NOT NULL DEFAULT 0,
+    archived_items_days INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- services/backlog-service/migrations/000013_retention_policies.down.sql
+
+DROP TABLE IF EXISTS retention_policies;
@@ -0,0 +1,146 @@
+// services/backlog-service/internal/domain/service/retention.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// RetentionService manages per-workspace retention policies. Every policy
+// change is recorded as an audit event: retention controls what gets
+// deleted, so its own history must survive.
+type RetentionService struct {
+	retention      repository.RetentionRepository
+	eventRepo      repository.EventRepository
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewRetentionService creates a new retention service
+func NewRetentionService(retention repository.RetentionRepository, eventRepo repository.EventRepository, eventPublisher event.Publisher, logger *zap.Logger) *RetentionService {
+	return &RetentionService{
+		retention:      retention,
+		eventRepo:      eventRepo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// GetRetentionPolicy retrieves a workspace's retention policy, nil when
+// none is configured
+func (s *RetentionService) GetRetentionPolicy(ctx context.Context, workspaceID uuid.UUID) (*repository.RetentionPolicy, error) {
+	return s.retention.GetRetentionPolicy(ctx, workspaceID)
+}
+
+// SetRetentionPolicy creates or replaces a workspace's retention policy and
+// emits the retention-change audit event carrying the old and new windows
+func (s *RetentionService) SetRetentionPolicy(ctx context.Context, policy *repository.RetentionPolicy, actor string) error {
+	if policy.EventsDays < 0 || policy.AuditDays < 0 || policy.ActivityDays < 0 || policy.ArchivedItemsDays < 0 {
+		return errors.New("retention windows cannot be negative")
+	}
+
+	previous, err := s.retention.GetRetentionPolicy(ctx, policy.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.retention.SetRetentionPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	changeEvent := event.NewRetentionPolicyChangedEvent(
+		policy.WorkspaceID, policyWindows(previous), policyWindows(policy), actor)
+	if err := s.eventRepo.StoreEvent(ctx, changeEvent); err != nil {
+		s.logger.Error("Failed to store retention change event", zap.Error(err))
+	}
+	if err := s.eventPublisher.Publish(ctx, "backlog.retention.policy.changed", changeEvent); err != nil {
+		s.logger.Error("Failed to publish retention change event", zap.Error(err))
+	}
+
+	return nil
+}
+
+// policyWindows flattens a policy's retention windows for the audit event;
+// a nil policy (no previous configuration) yields an empty map
+func policyWindows(policy *repository.RetentionPolicy) map[string]int {
+	if policy == nil {
+		return map[string]int{}
+	}
+	return map[string]int{
+		"events":         policy.EventsDays,
+		"audit":          policy.AuditDays,
+		"activity":       policy.ActivityDays,
+		"archived_items": policy.ArchivedItemsDays,
+	}
+}
+
+// RetentionPurgeJob enforces retention policies on a schedule. Run it under
+// a worker lease so only one replica purges.
+type RetentionPurgeJob struct {
+	retention repository.RetentionRepository
+	logger    *zap.Logger
+}
+
+// NewRetentionPurgeJob creates a new retention purge job
+func NewRetentionPurgeJob(retention repository.RetentionRepository, logger *zap.Logger) *RetentionPurgeJob {
+	return &RetentionPurgeJob{
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run purges (or, under dryRun, only reports) expired rows for every
+// workspace with a policy and returns the per-workspace reports
+func (j *RetentionPurgeJob) Run(ctx context.Context, dryRun bool) ([]*repository.PurgeReport, error) {
+	policies, err := j.retention.ListRetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*repository.PurgeReport
+	for _, policy := range policies {
+		report, err := j.retention.PurgeExpired(ctx, policy, dryRun)
+		if err != nil {
+			j.logger.Error("Failed to purge expired data",
+				zap.String("workspaceID", policy.WorkspaceID.String()),
+				zap.Error(err))
+			continue
+		}
+		reports = append(reports, report)
+
+		j.logger.Info("Retention purge completed",
+			zap.String("workspaceID", policy.WorkspaceID.String()),
+			zap.Bool("dryRun", dryRun),
+			zap.Int64("events", report.Events),
+			zap.Int64("auditEntries", report.AuditEntries),
+			zap.Int64("activityEntries", report.ActivityEntries),
+			zap.Int64("archivedItems", report.ArchivedItems))
+	}
+
+	return reports, nil
+}
+
+// Start runs the purge daily
+func (j *RetentionPurgeJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := j.Run(ctx, false); err != nil {
+				j.logger.Error("Retention purge job failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+-- services/backlog-service/migrations/000002_row_level_security.up.sql
+
+-- Add workspace scoping column for multi-tenant deployments. Single-tenant
+-- installs keep the default and RLS is simply never enforced for them.
+ALTER TABLE backlog_items ADD COLUMN workspace_id UUID;
+ALTER TABLE comments ADD COLUMN workspace_id UUID;
+
+CREATE INDEX idx_backlog_items_workspace_id ON backlog_items(workspace_id);
+CREATE INDEX idx_comments_workspace_id ON comments(workspace_id);
+
+-- Enable row-level security. Policies read the identity the application sets
+-- per connection via set_config('app.workspace_id', ...) and
+-- set_config('app.user_id', ...).
+ALTER TABLE backlog_items ENABLE ROW LEVEL SECURITY;
+ALTER TABLE comments ENABLE ROW LEVEL SECURITY;
+
+-- Workspace isolation: rows are only visible when the connection's workspace
+-- matches. Migrations and admin tooling run as the table owner, which is
+-- exempt from RLS unless FORCE ROW LEVEL SECURITY is set.
+CREATE POLICY backlog_items_workspace_isolation ON backlog_items
+    USING (workspace_id::text = current_setting('app.workspace_id', true))
+    WITH CHECK (workspace_id::text = current_setting('app.workspace_id', true));
+
+CREATE POLICY comments_workspace_isolation ON comments
+    USING (workspace_id::text = current_setting('app.workspace_id', true))
+    WITH CHECK (workspace_id::text = current_setting('app.workspace_id', true));
+
+-- services/backlog-service/migrations/000002_row_level_security.down.sql
+
+DROP POLICY IF EXISTS comments_workspace_isolation ON comments;
+DROP POLICY IF EXISTS backlog_items_workspace_isolation ON backlog_items;
+
+ALTER TABLE comments DISABLE ROW LEVEL SECURITY;
+ALTER TABLE backlog_items DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS idx_comments_workspace_id;
+DROP INDEX IF EXISTS idx_backlog_items_workspace_id;
+
+ALTER TABLE comments DROP COLUMN workspace_id;
+ALTER TABLE backlog_items DROP COLUMN workspace_id;
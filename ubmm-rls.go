@@ -0,0 +1,97 @@
+// services/backlog-service/internal/adapters/db/rls.go
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// rlsContextKey is the context key type for row-level security identity
+type rlsContextKey string
+
+const (
+	workspaceIDKey rlsContextKey = "rls_workspace_id"
+	userIDKey      rlsContextKey = "rls_user_id"
+)
+
+// WithRLSIdentity attaches the workspace and user identity to a context so
+// repository calls can set the corresponding Postgres GUCs
+func WithRLSIdentity(ctx context.Context, workspaceID, userID string) context.Context {
+	ctx = context.WithValue(ctx, workspaceIDKey, workspaceID)
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// RLSIdentityFromContext extracts the workspace and user identity from a
+// context; empty strings mean no identity was set
+func RLSIdentityFromContext(ctx context.Context) (workspaceID, userID string) {
+	if v, ok := ctx.Value(workspaceIDKey).(string); ok {
+		workspaceID = v
+	}
+	if v, ok := ctx.Value(userIDKey).(string); ok {
+		userID = v
+	}
+	return workspaceID, userID
+}
+
+// RLSSession pins a connection from the pool and sets the row-level security
+// GUCs (app.workspace_id, app.user_id) that the policies in the migrations
+// reference, so every query on the connection is scoped to the caller
+type RLSSession struct {
+	conn   *sqlx.Conn
+	logger *zap.Logger
+}
+
+// NewRLSSession acquires a connection and applies the identity from the
+// context. Callers must Close the session to return the connection to the
+// pool with the GUCs reset.
+func NewRLSSession(ctx context.Context, db *sqlx.DB, logger *zap.Logger) (*RLSSession, error) {
+	workspaceID, userID := RLSIdentityFromContext(ctx)
+	if workspaceID == "" {
+		return nil, fmt.Errorf("no workspace identity in context")
+	}
+
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	// set_config with is_local=false scopes the GUC to the session; we reset
+	// it on Close before the connection returns to the pool
+	if _, err := conn.ExecContext(ctx,
+		`SELECT set_config('app.workspace_id', $1, false), set_config('app.user_id', $2, false)`,
+		workspaceID, userID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set RLS identity: %w", err)
+	}
+
+	return &RLSSession{conn: conn, logger: logger}, nil
+}
+
+// Conn returns the pinned connection for running queries under the identity
+func (s *RLSSession) Conn() *sqlx.Conn {
+	return s.conn
+}
+
+// Close resets the identity GUCs and returns the connection to the pool
+func (s *RLSSession) Close(ctx context.Context) error {
+	if _, err := s.conn.ExecContext(ctx,
+		`SELECT set_config('app.workspace_id', '', false), set_config('app.user_id', '', false)`); err != nil {
+		s.logger.Error("Failed to reset RLS identity", zap.Error(err))
+	}
+	return s.conn.Close()
+}
+
+// QueryRowContext runs a single-row query on the pinned connection
+func (s *RLSSession) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return s.conn.QueryRowContext(ctx, query, args...)
+}
+
+// ExecContext runs a statement on the pinned connection
+func (s *RLSSession) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return s.conn.ExecContext(ctx, query, args...)
+}
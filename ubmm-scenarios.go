@@ -0,0 +1,276 @@
+// services/backlog-service/internal/domain/service/scenarios.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ScenarioOverride is one virtual change inside a scenario; nil fields leave
+// the real value untouched
+type ScenarioOverride struct {
+	ItemID      uuid.UUID  `json:"itemId"`
+	Priority    *int       `json:"priority,omitempty"`
+	ParentID    *uuid.UUID `json:"parentId,omitempty"`
+	StoryPoints *int       `json:"storyPoints,omitempty"`
+	Dropped     bool       `json:"dropped,omitempty"` // exclude the item from the plan
+}
+
+// Scenario is a what-if plan: a named set of virtual item changes and a
+// capacity adjustment, evaluated without touching real data
+type Scenario struct {
+	ID             uuid.UUID                       `json:"id"`
+	Name           string                          `json:"name"`
+	CreatedBy      string                          `json:"createdBy"`
+	CreatedAt      time.Time                       `json:"createdAt"`
+	CapacityFactor float64                         `json:"capacityFactor"` // 1.0 = current team capacity
+	Overrides      map[uuid.UUID]*ScenarioOverride `json:"overrides"`
+	AppliedAt      *time.Time                      `json:"appliedAt,omitempty"`
+}
+
+// ScenarioForecast is the forecaster's output for one scenario
+type ScenarioForecast struct {
+	ScenarioID          uuid.UUID  `json:"scenarioId"`
+	Name                string     `json:"name"`
+	RemainingPoints     int        `json:"remainingPoints"`
+	PointsPerWeek       float64    `json:"pointsPerWeek"`
+	ProjectedWeeks      float64    `json:"projectedWeeks"`
+	ProjectedCompletion *time.Time `json:"projectedCompletion,omitempty"`
+}
+
+// ScenarioRepository persists scenarios
+type ScenarioRepository interface {
+	// Create stores a new scenario
+	Create(ctx context.Context, scenario *Scenario) error
+
+	// Update updates an existing scenario
+	Update(ctx context.Context, scenario *Scenario) error
+
+	// GetByID retrieves a scenario by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Scenario, error)
+
+	// List lists all scenarios
+	List(ctx context.Context) ([]*Scenario, error)
+}
+
+// ScenarioService runs the what-if planning sandbox
+type ScenarioService struct {
+	backlog   *BacklogService
+	scenarios ScenarioRepository
+	logger    *zap.Logger
+}
+
+// NewScenarioService creates a new scenario service
+func NewScenarioService(backlog *BacklogService, scenarios ScenarioRepository, logger *zap.Logger) *ScenarioService {
+	return &ScenarioService{
+		backlog:   backlog,
+		scenarios: scenarios,
+		logger:    logger,
+	}
+}
+
+// CreateScenario creates an empty scenario with the current capacity
+func (s *ScenarioService) CreateScenario(ctx context.Context, name, createdBy string) (*Scenario, error) {
+	if name == "" {
+		return nil, errors.New("scenario name cannot be empty")
+	}
+	scenario := &Scenario{
+		ID:             uuid.New(),
+		Name:           name,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now().UTC(),
+		CapacityFactor: 1.0,
+		Overrides:      make(map[uuid.UUID]*ScenarioOverride),
+	}
+	if err := s.scenarios.Create(ctx, scenario); err != nil {
+		return nil, fmt.Errorf("failed to store scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+// SetOverride records a virtual change for an item inside a scenario
+func (s *ScenarioService) SetOverride(ctx context.Context, scenarioID uuid.UUID, override *ScenarioOverride) (*Scenario, error) {
+	scenario, err := s.scenarios.GetByID(ctx, scenarioID)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.AppliedAt != nil {
+		return nil, errors.New("cannot modify an applied scenario")
+	}
+
+	// Verify the item exists before overriding it
+	if _, err := s.backlog.GetItem(ctx, override.ItemID); err != nil {
+		return nil, err
+	}
+
+	scenario.Overrides[override.ItemID] = override
+	if err := s.scenarios.Update(ctx, scenario); err != nil {
+		return nil, fmt.Errorf("failed to update scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+// SetCapacityFactor adjusts the scenario's virtual team capacity (e.g. 1.2
+// models hiring, 0.8 models losing an engineer)
+func (s *ScenarioService) SetCapacityFactor(ctx context.Context, scenarioID uuid.UUID, factor float64) (*Scenario, error) {
+	if factor <= 0 {
+		return nil, errors.New("capacity factor must be positive")
+	}
+	scenario, err := s.scenarios.GetByID(ctx, scenarioID)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.AppliedAt != nil {
+		return nil, errors.New("cannot modify an applied scenario")
+	}
+	scenario.CapacityFactor = factor
+	if err := s.scenarios.Update(ctx, scenario); err != nil {
+		return nil, fmt.Errorf("failed to update scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+// Forecast runs the completion forecaster against a scenario: remaining
+// points under the scenario's overrides divided by the historical completion
+// rate scaled by the capacity factor
+func (s *ScenarioService) Forecast(ctx context.Context, scenarioID uuid.UUID) (*ScenarioForecast, error) {
+	scenario, err := s.scenarios.GetByID(ctx, scenarioID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := s.remainingPoints(ctx, scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := s.backlog.metricsRepo.GetWeeklyCompletion(ctx, 12)
+	if err != nil {
+		return nil, err
+	}
+	pointsPerWeek := 0.0
+	for _, rate := range completion {
+		pointsPerWeek += rate.PointsPerWeek
+	}
+	pointsPerWeek *= scenario.CapacityFactor
+
+	forecast := &ScenarioForecast{
+		ScenarioID:      scenario.ID,
+		Name:            scenario.Name,
+		RemainingPoints: remaining,
+		PointsPerWeek:   pointsPerWeek,
+	}
+	if pointsPerWeek > 0 {
+		forecast.ProjectedWeeks = float64(remaining) / pointsPerWeek
+		completion := time.Now().UTC().Add(time.Duration(forecast.ProjectedWeeks * float64(7*24) * float64(time.Hour)))
+		forecast.ProjectedCompletion = &completion
+	}
+
+	return forecast, nil
+}
+
+// Compare forecasts several scenarios side by side
+func (s *ScenarioService) Compare(ctx context.Context, scenarioIDs []uuid.UUID) ([]*ScenarioForecast, error) {
+	forecasts := make([]*ScenarioForecast, 0, len(scenarioIDs))
+	for _, id := range scenarioIDs {
+		forecast, err := s.Forecast(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		forecasts = append(forecasts, forecast)
+	}
+	return forecasts, nil
+}
+
+// Apply commits a scenario's overrides to the real backlog. Priority changes
+// go through the batch reorder so they land atomically; other overrides are
+// applied item by item.
+func (s *ScenarioService) Apply(ctx context.Context, scenarioID uuid.UUID) error {
+	scenario, err := s.scenarios.GetByID(ctx, scenarioID)
+	if err != nil {
+		return err
+	}
+	if scenario.AppliedAt != nil {
+		return errors.New("scenario already applied")
+	}
+
+	var reorders []ReorderRequest
+	for itemID, override := range scenario.Overrides {
+		if override.Dropped {
+			continue
+		}
+		if override.Priority != nil {
+			reorders = append(reorders, ReorderRequest{ItemID: itemID, NewPriority: *override.Priority})
+		}
+		if override.ParentID != nil || override.StoryPoints != nil {
+			req := &UpdateItemRequest{
+				ParentID:    override.ParentID,
+				StoryPoints: override.StoryPoints,
+			}
+			if _, err := s.backlog.UpdateItem(ctx, itemID, req); err != nil {
+				return fmt.Errorf("failed to apply override for %s: %w", itemID, err)
+			}
+		}
+	}
+	if len(reorders) > 0 {
+		if err := s.backlog.ReorderItems(ctx, reorders); err != nil {
+			return fmt.Errorf("failed to apply scenario priorities: %w", err)
+		}
+	}
+
+	now := time.Now().UTC()
+	scenario.AppliedAt = &now
+	if err := s.scenarios.Update(ctx, scenario); err != nil {
+		return fmt.Errorf("failed to mark scenario applied: %w", err)
+	}
+
+	s.logger.Info("Scenario applied",
+		zap.String("scenarioId", scenarioID.String()),
+		zap.Int("overrides", len(scenario.Overrides)))
+
+	return nil
+}
+
+// remainingPoints sums open story points with the scenario's overrides applied
+func (s *ScenarioService) remainingPoints(ctx context.Context, scenario *Scenario) (int, error) {
+	remaining := 0
+	offset := 0
+	for {
+		items, total, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+			Limit:  200,
+			Offset: offset,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range items {
+			if item.Status == model.ItemStatusDone {
+				continue
+			}
+			points := item.StoryPoints
+			if override, ok := scenario.Overrides[item.ID]; ok {
+				if override.Dropped {
+					continue
+				}
+				if override.StoryPoints != nil {
+					points = *override.StoryPoints
+				}
+			}
+			remaining += points
+		}
+		offset += len(items)
+		if int64(offset) >= total || len(items) == 0 {
+			break
+		}
+	}
+	return remaining, nil
+}
@@ -0,0 +1,197 @@
+// services/backlog-service/cmd/seed/main.go
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// seed generates a deterministic demo backlog directly in Postgres:
+// a realistic hierarchy, status distribution and months of event history so
+// metrics, forecasting and UI demos work out of the box.
+//
+//	go run ./cmd/seed -dsn "$DATABASE_URL" -epics 8 -months 6 -seed 42
+func main() {
+	var (
+		dsn        = flag.String("dsn", "", "Postgres DSN (required)")
+		epics      = flag.Int("epics", 8, "number of epics")
+		features   = flag.Int("features", 4, "features per epic")
+		stories    = flag.Int("stories", 6, "stories per feature")
+		months     = flag.Int("months", 6, "how many months of history to spread items over")
+		randomSeed = flag.Int64("seed", 42, "random seed; the same seed always produces the same backlog")
+	)
+	flag.Parse()
+
+	if *dsn == "" {
+		log.Fatal("-dsn is required")
+	}
+
+	db, err := sqlx.Open("postgres", *dsn)
+	if err != nil {
+		log.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	rng := rand.New(rand.NewSource(*randomSeed))
+	gen := &generator{db: db, rng: rng, start: time.Now().UTC().AddDate(0, -*months, 0)}
+
+	ctx := context.Background()
+	created, err := gen.run(ctx, *epics, *features, *stories)
+	if err != nil {
+		log.Fatalf("seeding failed: %v", err)
+	}
+	fmt.Printf("seeded %d items spanning %d months\n", created, *months)
+}
+
+// statusWeights is the demo status distribution: mostly open work with a
+// believable completed tail
+var statusWeights = []struct {
+	status string
+	weight int
+}{
+	{"NEW", 35},
+	{"READY", 15},
+	{"IN_PROGRESS", 10},
+	{"BLOCKED", 5},
+	{"DONE", 35},
+}
+
+var demoAssignees = []string{"alice", "bob", "carol", "dave", "erin", ""}
+
+var demoTitleWords = []string{
+	"search", "billing", "onboarding", "notifications", "export",
+	"permissions", "dashboard", "sync", "audit", "performance",
+}
+
+type generator struct {
+	db    *sqlx.DB
+	rng   *rand.Rand
+	start time.Time
+}
+
+// run creates the full hierarchy and its event history
+func (g *generator) run(ctx context.Context, epics, featuresPerEpic, storiesPerFeature int) (int, error) {
+	created := 0
+	for e := 0; e < epics; e++ {
+		epicID, err := g.insertItem(ctx, "EPIC", nil, fmt.Sprintf("Epic: %s platform", g.word()), 0)
+		if err != nil {
+			return created, err
+		}
+		created++
+
+		for f := 0; f < featuresPerEpic; f++ {
+			featureID, err := g.insertItem(ctx, "FEATURE", &epicID, fmt.Sprintf("Feature: %s %s", g.word(), g.word()), 0)
+			if err != nil {
+				return created, err
+			}
+			created++
+
+			for s := 0; s < storiesPerFeature; s++ {
+				points := []int{1, 2, 3, 5, 8}[g.rng.Intn(5)]
+				if _, err := g.insertItem(ctx, "STORY", &featureID, fmt.Sprintf("Story: %s for %s", g.word(), g.word()), points); err != nil {
+					return created, err
+				}
+				created++
+			}
+		}
+	}
+	return created, nil
+}
+
+// insertItem writes one item plus its creation (and, for non-NEW statuses,
+// update) events with timestamps spread across the history window
+func (g *generator) insertItem(ctx context.Context, itemType string, parentID *uuid.UUID, title string, points int) (uuid.UUID, error) {
+	id := uuid.New()
+	status := g.status()
+	createdAt := g.timestamp()
+	updatedAt := createdAt
+	if status != "NEW" {
+		// Work happened some time after creation
+		updatedAt = createdAt.Add(time.Duration(g.rng.Intn(30*24)) * time.Hour)
+	}
+
+	_, err := g.db.ExecContext(ctx, `
+		INSERT INTO backlog_items
+			(id, type, parent_id, title, description, story_points, status, priority, assignee, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, id, itemType, parentID, title, "Seeded demo item.", points, status,
+		g.rng.Intn(1000), demoAssignees[g.rng.Intn(len(demoAssignees))], createdAt, updatedAt)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to insert item: %w", err)
+	}
+
+	if err := g.insertEvent(ctx, id, "ITEM_CREATED", createdAt, title, itemType, "NEW", points); err != nil {
+		return uuid.Nil, err
+	}
+	if status != "NEW" {
+		if err := g.insertEvent(ctx, id, "ITEM_UPDATED", updatedAt, title, itemType, status, points); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return id, nil
+}
+
+// insertEvent writes one row to the events table in the shape the service's
+// own events use
+func (g *generator) insertEvent(ctx context.Context, itemID uuid.UUID, eventType string, at time.Time, title, itemType, status string, points int) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"itemId": itemID,
+		"item": map[string]interface{}{
+			"id":          itemID,
+			"title":       title,
+			"type":        itemType,
+			"status":      status,
+			"storyPoints": points,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = g.db.ExecContext(ctx, `
+		INSERT INTO events (id, item_id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, uuid.New(), itemID, eventType, payload, at)
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %w", err)
+	}
+	return nil
+}
+
+// status draws from the weighted demo distribution
+func (g *generator) status() string {
+	total := 0
+	for _, w := range statusWeights {
+		total += w.weight
+	}
+	roll := g.rng.Intn(total)
+	for _, w := range statusWeights {
+		if roll < w.weight {
+			return w.status
+		}
+		roll -= w.weight
+	}
+	return "NEW"
+}
+
+// timestamp picks a creation time inside the history window
+func (g *generator) timestamp() time.Time {
+	window := time.Since(g.start)
+	return g.start.Add(time.Duration(g.rng.Int63n(int64(window))))
+}
+
+// word picks a demo vocabulary word
+func (g *generator) word() string {
+	return demoTitleWords[g.rng.Intn(len(demoTitleWords))]
+}
@@ -0,0 +1,258 @@
+// services/backlog-service/internal/domain/service/share_tokens.go
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// ShareScope identifies what a share token grants read access to
+type ShareScope string
+
+const (
+	// ShareScopeItem grants read access to a single backlog item
+	ShareScopeItem ShareScope = "ITEM"
+	// ShareScopeSavedView grants read access to a saved view's results
+	ShareScopeSavedView ShareScope = "SAVED_VIEW"
+)
+
+// ShareToken is a time-limited, read-only grant for external stakeholders
+// who have no account
+type ShareToken struct {
+	ID        uuid.UUID  `json:"id"`
+	Token     string     `json:"token"`
+	Scope     ShareScope `json:"scope"`
+	TargetID  uuid.UUID  `json:"targetId"`
+	CreatedBy string     `json:"createdBy"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// ShareAccess is one access-log entry for a share token
+type ShareAccess struct {
+	TokenID    uuid.UUID `json:"tokenId"`
+	RemoteAddr string    `json:"remoteAddr"`
+	UserAgent  string    `json:"userAgent"`
+	AccessedAt time.Time `json:"accessedAt"`
+}
+
+// ShareTokenRepository persists share tokens and their access log
+type ShareTokenRepository interface {
+	// Create stores a new share token
+	Create(ctx context.Context, token *ShareToken) error
+
+	// GetByToken retrieves a share token by its opaque token string
+	GetByToken(ctx context.Context, token string) (*ShareToken, error)
+
+	// Revoke marks a token as revoked
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+
+	// ListByTarget lists tokens issued for a target
+	ListByTarget(ctx context.Context, targetID uuid.UUID) ([]*ShareToken, error)
+
+	// LogAccess appends an access-log entry
+	LogAccess(ctx context.Context, access *ShareAccess) error
+}
+
+// ErrShareTokenInvalid is returned for unknown, expired or revoked tokens
+var ErrShareTokenInvalid = errors.New("share token is invalid or expired")
+
+// ShareService issues and resolves expiring read-only share tokens
+type ShareService struct {
+	tokens ShareTokenRepository
+	repo   repository.BacklogRepository
+	logger *zap.Logger
+}
+
+// NewShareService creates a new share service
+func NewShareService(tokens ShareTokenRepository, repo repository.BacklogRepository, logger *zap.Logger) *ShareService {
+	return &ShareService{
+		tokens: tokens,
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CreateToken issues a new share token for an item or saved view. ttl is
+// capped at 90 days.
+func (s *ShareService) CreateToken(ctx context.Context, scope ShareScope, targetID uuid.UUID, createdBy string, ttl time.Duration) (*ShareToken, error) {
+	if scope != ShareScopeItem && scope != ShareScopeSavedView {
+		return nil, fmt.Errorf("invalid share scope: %s", scope)
+	}
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	if max := 90 * 24 * time.Hour; ttl > max {
+		ttl = max
+	}
+
+	// Verify the target exists before issuing a grant for it
+	if scope == ShareScopeItem {
+		if _, err := s.repo.GetByID(ctx, targetID); err != nil {
+			return nil, fmt.Errorf("failed to resolve share target: %w", err)
+		}
+	}
+
+	opaque, err := generateShareToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	token := &ShareToken{
+		ID:        uuid.New(),
+		Token:     opaque,
+		Scope:     scope,
+		TargetID:  targetID,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := s.tokens.Create(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to store share token: %w", err)
+	}
+
+	s.logger.Info("Share token created",
+		zap.String("scope", string(scope)),
+		zap.String("targetId", targetID.String()),
+		zap.Time("expiresAt", token.ExpiresAt))
+
+	return token, nil
+}
+
+// RevokeToken revokes a share token so it stops resolving immediately
+func (s *ShareService) RevokeToken(ctx context.Context, id uuid.UUID) error {
+	return s.tokens.Revoke(ctx, id, time.Now().UTC())
+}
+
+// ListTokens lists the tokens issued for a target
+func (s *ShareService) ListTokens(ctx context.Context, targetID uuid.UUID) ([]*ShareToken, error) {
+	return s.tokens.ListByTarget(ctx, targetID)
+}
+
+// ResolveItem validates a token and returns the shared item. remoteAddr and
+// userAgent are recorded in the access log.
+func (s *ShareService) ResolveItem(ctx context.Context, opaque, remoteAddr, userAgent string) (*model.BacklogItem, error) {
+	token, err := s.resolve(ctx, opaque, remoteAddr, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	if token.Scope != ShareScopeItem {
+		return nil, ErrShareTokenInvalid
+	}
+	return s.repo.GetByID(ctx, token.TargetID)
+}
+
+// ResolveToken validates a token and returns it with an access-log entry
+// recorded; saved-view handlers use the returned target to run the view
+func (s *ShareService) ResolveToken(ctx context.Context, opaque, remoteAddr, userAgent string) (*ShareToken, error) {
+	return s.resolve(ctx, opaque, remoteAddr, userAgent)
+}
+
+// resolve validates a token's existence, expiry and revocation, and logs the
+// access
+func (s *ShareService) resolve(ctx context.Context, opaque, remoteAddr, userAgent string) (*ShareToken, error) {
+	token, err := s.tokens.GetByToken(ctx, opaque)
+	if err != nil {
+		return nil, ErrShareTokenInvalid
+	}
+	now := time.Now().UTC()
+	if token.RevokedAt != nil || now.After(token.ExpiresAt) {
+		return nil, ErrShareTokenInvalid
+	}
+
+	if err := s.tokens.LogAccess(ctx, &ShareAccess{
+		TokenID:    token.ID,
+		RemoteAddr: remoteAddr,
+		UserAgent:  userAgent,
+		AccessedAt: now,
+	}); err != nil {
+		s.logger.Error("Failed to log share token access", zap.Error(err))
+	}
+
+	return token, nil
+}
+
+// generateShareToken produces an unguessable URL-safe token string
+func generateShareToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// services/backlog-service/internal/adapters/httpapi/share_handler.go
+
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// ShareHandler serves GET /share/{token}: a read-only view of a shared item
+// that requires no authentication beyond the token itself
+type ShareHandler struct {
+	shares *service.ShareService
+	logger *zap.Logger
+}
+
+// NewShareHandler creates a new share handler
+func NewShareHandler(shares *service.ShareService, logger *zap.Logger) *ShareHandler {
+	return &ShareHandler{
+		shares: shares,
+		logger: logger,
+	}
+}
+
+// ServeHTTP handles the share request
+func (h *ShareHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.URL.Path, "/share/")
+	if token == "" || strings.Contains(token, "/") {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	item, err := h.shares.ResolveItem(r.Context(), token, r.RemoteAddr, r.UserAgent())
+	if err != nil {
+		if errors.Is(err, service.ErrShareTokenInvalid) {
+			// Expired, revoked and unknown tokens are indistinguishable to
+			// avoid leaking which tokens ever existed
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Failed to resolve share token", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(item); err != nil {
+		h.logger.Error("Failed to encode shared item", zap.Error(err))
+	}
+}
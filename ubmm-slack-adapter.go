@@ -0,0 +1,211 @@
+// services/backlog-service/internal/adapters/integration/slack/adapter.go
+
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// UserMapper maps Slack user IDs to UBMM user identities
+type UserMapper interface {
+	// ResolveSlackUser returns the UBMM user for a Slack user ID, or empty
+	// if unmapped
+	ResolveSlackUser(ctx context.Context, slackUserID string) (string, error)
+}
+
+// Config holds the Slack app settings
+type Config struct {
+	SigningSecret string
+}
+
+// CommandResponse is the message returned to Slack for a slash command
+type CommandResponse struct {
+	ResponseType string `json:"response_type"` // "ephemeral" or "in_channel"
+	Text         string `json:"text"`
+}
+
+// Adapter implements the /ubmm slash commands, link unfurling and interactive
+// actions, all routed through the existing service layer
+type Adapter struct {
+	cfg     Config
+	backlog *service.BacklogService
+	users   UserMapper
+	logger  *zap.Logger
+}
+
+// NewAdapter creates a new Slack adapter
+func NewAdapter(cfg Config, backlog *service.BacklogService, users UserMapper, logger *zap.Logger) *Adapter {
+	return &Adapter{
+		cfg:     cfg,
+		backlog: backlog,
+		users:   users,
+		logger:  logger,
+	}
+}
+
+// VerifySignature checks the Slack request signature (v0 scheme)
+func (a *Adapter) VerifySignature(timestamp, body, signature string) bool {
+	base := "v0:" + timestamp + ":" + body
+	mac := hmac.New(sha256.New, []byte(a.cfg.SigningSecret))
+	mac.Write([]byte(base))
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// HandleCommand dispatches a /ubmm slash command: create, list, or move
+func (a *Adapter) HandleCommand(ctx context.Context, slackUserID, text string) (*CommandResponse, error) {
+	parts := strings.Fields(text)
+	if len(parts) == 0 {
+		return usage(), nil
+	}
+
+	switch parts[0] {
+	case "create":
+		return a.handleCreate(ctx, slackUserID, strings.Join(parts[1:], " "))
+	case "list":
+		return a.handleList(ctx)
+	case "move":
+		if len(parts) != 3 {
+			return ephemeral("Usage: /ubmm move <item-id> <status>"), nil
+		}
+		return a.handleMove(ctx, parts[1], parts[2])
+	default:
+		return usage(), nil
+	}
+}
+
+// handleCreate creates a story from the command text
+func (a *Adapter) handleCreate(ctx context.Context, slackUserID, title string) (*CommandResponse, error) {
+	if title == "" {
+		return ephemeral("Usage: /ubmm create <title>"), nil
+	}
+
+	assignee, err := a.users.ResolveSlackUser(ctx, slackUserID)
+	if err != nil {
+		a.logger.Error("Failed to resolve Slack user",
+			zap.String("slackUserId", slackUserID),
+			zap.Error(err))
+	}
+
+	item, err := a.backlog.CreateItem(ctx, &service.CreateItemRequest{
+		Type:     model.ItemTypeStory,
+		Title:    title,
+		Assignee: assignee,
+		Tags:     []string{"slack"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create item from Slack: %w", err)
+	}
+
+	return &CommandResponse{
+		ResponseType: "in_channel",
+		Text:         fmt.Sprintf("Created story *%s* (`%s`)", item.Title, item.ID),
+	}, nil
+}
+
+// handleList shows the top of the backlog
+func (a *Adapter) handleList(ctx context.Context) (*CommandResponse, error) {
+	items, _, err := a.backlog.ListItems(ctx, repository.BacklogFilter{
+		Limit:     10,
+		SortBy:    "priority",
+		SortOrder: "asc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items for Slack: %w", err)
+	}
+
+	if len(items) == 0 {
+		return ephemeral("The backlog is empty."), nil
+	}
+
+	var lines []string
+	for _, item := range items {
+		lines = append(lines, fmt.Sprintf("• [%s] *%s* — %s", item.Type, item.Title, item.Status))
+	}
+	return ephemeral(strings.Join(lines, "\n")), nil
+}
+
+// handleMove changes an item's status
+func (a *Adapter) handleMove(ctx context.Context, itemIDStr, statusStr string) (*CommandResponse, error) {
+	itemID, err := uuid.Parse(itemIDStr)
+	if err != nil {
+		return ephemeral("Invalid item ID."), nil
+	}
+
+	status := model.ItemStatus(strings.ToUpper(statusStr))
+	if _, err := a.backlog.UpdateItem(ctx, itemID, &service.UpdateItemRequest{Status: &status}); err != nil {
+		return ephemeral(fmt.Sprintf("Failed to move item: %v", err)), nil
+	}
+
+	return &CommandResponse{
+		ResponseType: "in_channel",
+		Text:         fmt.Sprintf("Moved `%s` to *%s*", itemID, status),
+	}, nil
+}
+
+// Unfurl builds a rich preview block for an item link shared in Slack
+func (a *Adapter) Unfurl(ctx context.Context, itemID uuid.UUID) (map[string]interface{}, error) {
+	item, err := a.backlog.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"title": fmt.Sprintf("[%s] %s", item.Type, item.Title),
+		"text":  item.Description,
+		"fields": []map[string]interface{}{
+			{"title": "Status", "value": string(item.Status), "short": true},
+			{"title": "Points", "value": fmt.Sprintf("%d", item.StoryPoints), "short": true},
+			{"title": "Assignee", "value": item.Assignee, "short": true},
+		},
+	}, nil
+}
+
+// InteractiveAction describes a button press in an unfurled item message
+type InteractiveAction struct {
+	SlackUserID string
+	ItemID      uuid.UUID
+	Action      string // "set_status" or "set_assignee"
+	Value       string
+}
+
+// HandleInteraction applies a button action from Slack through the service layer
+func (a *Adapter) HandleInteraction(ctx context.Context, action InteractiveAction) error {
+	switch action.Action {
+	case "set_status":
+		status := model.ItemStatus(action.Value)
+		_, err := a.backlog.UpdateItem(ctx, action.ItemID, &service.UpdateItemRequest{Status: &status})
+		return err
+	case "set_assignee":
+		assignee, err := a.users.ResolveSlackUser(ctx, action.Value)
+		if err != nil {
+			return fmt.Errorf("failed to resolve Slack user: %w", err)
+		}
+		_, err = a.backlog.UpdateItem(ctx, action.ItemID, &service.UpdateItemRequest{Assignee: &assignee})
+		return err
+	default:
+		return fmt.Errorf("unknown Slack action %q", action.Action)
+	}
+}
+
+// Helper responses
+
+func usage() *CommandResponse {
+	return ephemeral("Usage: /ubmm create <title> | list | move <item-id> <status>")
+}
+
+func ephemeral(text string) *CommandResponse {
+	return &CommandResponse{ResponseType: "ephemeral", Text: text}
+}
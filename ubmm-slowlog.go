@@ -0,0 +1,120 @@
+// services/backlog-service/internal/adapters/db/slowlog.go
+
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// maxLoggedQueryLength caps how much SQL one slow query log line carries
+const maxLoggedQueryLength = 500
+
+// slowQueries counts repository queries over the configured threshold; the
+// operation label names the repository method, not the SQL
+var slowQueries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "ubmm_slow_queries_total",
+		Help: "Repository queries exceeding the slow query threshold, by operation",
+	},
+	[]string{"operation"},
+)
+
+func init() {
+	prometheus.MustRegister(slowQueries)
+}
+
+// observeQuery logs and counts a query that ran longer than the configured
+// threshold. The SQL is positional-parameter only, so logging it never
+// leaks values; started is captured when the query is built.
+func (a *PostgresAdapter) observeQuery(ctx context.Context, operation, query string, started time.Time) {
+	if a.slowQueryThreshold <= 0 {
+		return
+	}
+	elapsed := time.Since(started)
+	if elapsed < a.slowQueryThreshold {
+		return
+	}
+
+	slowQueries.WithLabelValues(operation).Inc()
+
+	fields := []zap.Field{
+		zap.String("operation", operation),
+		zap.Duration("elapsed", elapsed),
+		zap.String("query", sanitizeSQL(query)),
+	}
+	if span := trace.SpanContextFromContext(ctx); span.HasTraceID() {
+		fields = append(fields, zap.String("traceID", span.TraceID().String()))
+	}
+	a.logger.Warn("Slow query", fields...)
+}
+
+// sanitizeSQL collapses whitespace and truncates the query for logging
+func sanitizeSQL(query string) string {
+	collapsed := strings.Join(strings.Fields(query), " ")
+	if len(collapsed) > maxLoggedQueryLength {
+		return collapsed[:maxLoggedQueryLength] + "…"
+	}
+	return collapsed
+}
+
+// services/backlog-service/internal/adapters/grpc/slowlog_interceptor.go
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// slowRPCs counts RPCs over the configured threshold, by full method name
+var slowRPCs = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "ubmm_slow_rpcs_total",
+		Help: "RPCs exceeding the slow RPC threshold, by method",
+	},
+	[]string{"method"},
+)
+
+func init() {
+	prometheus.MustRegister(slowRPCs)
+}
+
+// SlowRPCInterceptor logs and counts unary RPCs that run longer than
+// threshold, so regressions from new filter combinations show up in logs
+// and dashboards before users report them. A zero threshold disables it.
+func SlowRPCInterceptor(threshold time.Duration, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		started := time.Now()
+		resp, err := handler(ctx, req)
+
+		elapsed := time.Since(started)
+		if threshold > 0 && elapsed >= threshold {
+			slowRPCs.WithLabelValues(info.FullMethod).Inc()
+
+			fields := []zap.Field{
+				zap.String("method", info.FullMethod),
+				zap.Duration("elapsed", elapsed),
+			}
+			if span := trace.SpanContextFromContext(ctx); span.HasTraceID() {
+				fields = append(fields, zap.String("traceID", span.TraceID().String()))
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+			}
+			logger.Warn("Slow RPC", fields...)
+		}
+
+		return resp, err
+	}
+}
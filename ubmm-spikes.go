@@ -0,0 +1,93 @@
+// services/backlog-service/internal/domain/service/spikes.go
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// SpikeTimeboxJob emits warning events for in-progress spikes that have run
+// past their timebox without being closed
+type SpikeTimeboxJob struct {
+	repo           repository.BacklogRepository
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewSpikeTimeboxJob creates a new spike timebox job
+func NewSpikeTimeboxJob(repo repository.BacklogRepository, eventPublisher event.Publisher, logger *zap.Logger) *SpikeTimeboxJob {
+	return &SpikeTimeboxJob{
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// Run scans in-progress spikes and publishes an expiry warning for each one
+// whose timebox has elapsed. It is intended to be scheduled hourly.
+func (j *SpikeTimeboxJob) Run(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	const pageSize = 200
+	filter := repository.BacklogFilter{
+		Types:    []model.ItemType{model.ItemTypeSpike},
+		Statuses: []model.ItemStatus{model.ItemStatusInProgress},
+		Limit:    pageSize,
+	}
+
+	warned := 0
+	for {
+		items, _, err := j.repo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			if !item.TimeboxElapsed(now) {
+				continue
+			}
+
+			elapsed := now.Sub(*item.TimeboxStartedAt).Hours()
+			elapsedEvent := event.NewSpikeTimeboxElapsedEvent(item.ID, item.TimeboxHours, elapsed)
+			if err := j.eventPublisher.Publish(ctx, "backlog.item.spike.timebox_elapsed", elapsedEvent); err != nil {
+				j.logger.Error("Failed to publish spike timebox event",
+					zap.String("itemId", item.ID.String()),
+					zap.Error(err))
+				continue
+			}
+			warned++
+		}
+
+		filter.Offset += pageSize
+	}
+
+	j.logger.Info("Spike timebox job finished", zap.Int("warnings", warned))
+	return nil
+}
+
+// Start runs the job hourly until the context is cancelled
+func (j *SpikeTimeboxJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil {
+				j.logger.Error("Spike timebox job failed", zap.Error(err))
+			}
+		}
+	}
+}
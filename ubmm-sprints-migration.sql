@@ -0,0 +1,36 @@
+-- services/backlog-service/migrations/000015_sprints.up.sql
+
+-- Sprints (and releases: a sprint row with a longer window) are the
+-- timeboxes scope-change detection measures against
+CREATE TABLE IF NOT EXISTS sprints (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'PLANNED',
+    points_added INTEGER NOT NULL DEFAULT 0,
+    points_removed INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT sprints_name_not_empty CHECK (length(trim(name)) > 0),
+    CONSTRAINT sprints_window_valid CHECK (ends_at > starts_at)
+);
+
+-- Membership carries the points at commit time and the last points seen, so
+-- growth inside the sprint is a diff against the row, not the event log
+CREATE TABLE IF NOT EXISTS sprint_items (
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES backlog_items(id) ON DELETE CASCADE,
+    points_at_commit INTEGER NOT NULL,
+    points INTEGER NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (sprint_id, item_id)
+);
+
+-- The scope-change consumer looks up active-sprint memberships by item
+CREATE INDEX idx_sprint_items_item_id ON sprint_items(item_id);
+
+-- services/backlog-service/migrations/000015_sprints.down.sql
+
+DROP TABLE IF EXISTS sprint_items;
+DROP TABLE IF EXISTS sprints;
@@ -0,0 +1,419 @@
+// services/backlog-service/internal/domain/service/sprints.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// SprintStatus is the lifecycle state of a sprint
+type SprintStatus string
+
+const (
+	// SprintStatusPlanned is a sprint being filled; scope moves freely
+	SprintStatusPlanned SprintStatus = "PLANNED"
+	// SprintStatusActive is a started sprint; scope changes are tracked
+	SprintStatusActive SprintStatus = "ACTIVE"
+	// SprintStatusClosed is a finished sprint; scope is frozen
+	SprintStatusClosed SprintStatus = "CLOSED"
+)
+
+// Scope change kinds carried in the scope_changed event
+const (
+	// ScopeChangeAdded is an item added to an active sprint
+	ScopeChangeAdded = "ADDED"
+	// ScopeChangeRemoved is an item removed from an active sprint
+	ScopeChangeRemoved = "REMOVED"
+	// ScopeChangeResized is an item re-estimated inside an active sprint
+	ScopeChangeResized = "RESIZED"
+)
+
+// Sprint is a timebox items are committed to. Releases are modeled as
+// sprints with a longer window; scope tracking is identical.
+type Sprint struct {
+	ID            uuid.UUID    `json:"id"`
+	Name          string       `json:"name"`
+	StartsAt      time.Time    `json:"startsAt"`
+	EndsAt        time.Time    `json:"endsAt"`
+	Status        SprintStatus `json:"status"`
+	PointsAdded   int          `json:"pointsAdded"`
+	PointsRemoved int          `json:"pointsRemoved"`
+}
+
+// SprintItem is one item's membership in a sprint. Points is the last value
+// seen; PointsAtCommit never changes after the item joins.
+type SprintItem struct {
+	SprintID       uuid.UUID `json:"sprintId"`
+	ItemID         uuid.UUID `json:"itemId"`
+	PointsAtCommit int       `json:"pointsAtCommit"`
+	Points         int       `json:"points"`
+	AddedAt        time.Time `json:"addedAt"`
+}
+
+// SprintRepository persists sprints and their memberships
+type SprintRepository interface {
+	// CreateSprint stores a new sprint
+	CreateSprint(ctx context.Context, sprint *Sprint) error
+
+	// GetSprint retrieves a sprint by ID
+	GetSprint(ctx context.Context, id uuid.UUID) (*Sprint, error)
+
+	// UpdateSprintStatus moves a sprint to a new lifecycle state
+	UpdateSprintStatus(ctx context.Context, id uuid.UUID, status SprintStatus) error
+
+	// AddSprintItem stores a new membership
+	AddSprintItem(ctx context.Context, item *SprintItem) error
+
+	// RemoveSprintItem deletes a membership
+	RemoveSprintItem(ctx context.Context, sprintID, itemID uuid.UUID) error
+
+	// GetSprintItems retrieves a sprint's memberships, oldest first
+	GetSprintItems(ctx context.Context, sprintID uuid.UUID) ([]*SprintItem, error)
+
+	// GetActiveMembershipsForItem retrieves an item's memberships in
+	// active sprints
+	GetActiveMembershipsForItem(ctx context.Context, itemID uuid.UUID) ([]*SprintItem, error)
+
+	// UpdateSprintItemPoints updates a membership's last seen points
+	UpdateSprintItemPoints(ctx context.Context, sprintID, itemID uuid.UUID, points int) error
+
+	// AddScopeChurn adds to a sprint's running churn counters
+	AddScopeChurn(ctx context.Context, sprintID uuid.UUID, added, removed int) error
+}
+
+// SprintService manages sprint timeboxes and detects scope changes. Every
+// scope move against an active sprint — item added, removed, or re-sized —
+// becomes a scope_changed event and feeds the sprint's churn counters, so
+// retrospectives argue from data instead of memory.
+type SprintService struct {
+	backlog *BacklogService
+	sprints SprintRepository
+	logger  *zap.Logger
+}
+
+// NewSprintService creates a new sprint service
+func NewSprintService(backlog *BacklogService, sprints SprintRepository, logger *zap.Logger) *SprintService {
+	return &SprintService{
+		backlog: backlog,
+		sprints: sprints,
+		logger:  logger,
+	}
+}
+
+// CreateSprint creates a new planned sprint
+func (s *SprintService) CreateSprint(ctx context.Context, name string, startsAt, endsAt time.Time) (*Sprint, error) {
+	if name == "" {
+		return nil, errors.New("sprint name cannot be empty")
+	}
+	if !endsAt.After(startsAt) {
+		return nil, errors.New("sprint must end after it starts")
+	}
+
+	sprint := &Sprint{
+		ID:       uuid.New(),
+		Name:     name,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Status:   SprintStatusPlanned,
+	}
+	if err := s.sprints.CreateSprint(ctx, sprint); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+// StartSprint activates a planned sprint; scope changes are tracked from here
+func (s *SprintService) StartSprint(ctx context.Context, id uuid.UUID) error {
+	return s.transition(ctx, id, SprintStatusPlanned, SprintStatusActive)
+}
+
+// CloseSprint closes an active sprint; its scope and churn are frozen
+func (s *SprintService) CloseSprint(ctx context.Context, id uuid.UUID) error {
+	return s.transition(ctx, id, SprintStatusActive, SprintStatusClosed)
+}
+
+// transition moves a sprint between lifecycle states, rejecting any move
+// that does not start from the expected state
+func (s *SprintService) transition(ctx context.Context, id uuid.UUID, from, to SprintStatus) error {
+	sprint, err := s.sprints.GetSprint(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sprint.Status != from {
+		return fmt.Errorf("sprint is %s, not %s", sprint.Status, from)
+	}
+	return s.sprints.UpdateSprintStatus(ctx, id, to)
+}
+
+// AddItem commits an item to a sprint. Against an active sprint this is a
+// scope change and is tracked as such.
+func (s *SprintService) AddItem(ctx context.Context, sprintID, itemID uuid.UUID, actor string) error {
+	sprint, err := s.sprints.GetSprint(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status == SprintStatusClosed {
+		return errors.New("cannot change the scope of a closed sprint")
+	}
+
+	item, err := s.backlog.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	membership := &SprintItem{
+		SprintID:       sprintID,
+		ItemID:         itemID,
+		PointsAtCommit: item.StoryPoints,
+		Points:         item.StoryPoints,
+		AddedAt:        time.Now().UTC(),
+	}
+	if err := s.sprints.AddSprintItem(ctx, membership); err != nil {
+		return err
+	}
+
+	if sprint.Status == SprintStatusActive {
+		s.recordScopeChange(ctx, sprintID, itemID, ScopeChangeAdded, 0, item.StoryPoints, actor)
+	}
+	return nil
+}
+
+// RemoveItem takes an item out of a sprint. Against an active sprint this is
+// a scope change and is tracked as such.
+func (s *SprintService) RemoveItem(ctx context.Context, sprintID, itemID uuid.UUID, actor string) error {
+	sprint, err := s.sprints.GetSprint(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status == SprintStatusClosed {
+		return errors.New("cannot change the scope of a closed sprint")
+	}
+
+	// The membership's last seen points are what leaves the sprint
+	points := 0
+	memberships, err := s.sprints.GetSprintItems(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		if membership.ItemID == itemID {
+			points = membership.Points
+		}
+	}
+
+	if err := s.sprints.RemoveSprintItem(ctx, sprintID, itemID); err != nil {
+		return err
+	}
+
+	if sprint.Status == SprintStatusActive {
+		s.recordScopeChange(ctx, sprintID, itemID, ScopeChangeRemoved, points, 0, actor)
+	}
+	return nil
+}
+
+// RecordItemResized updates an item's points in every active sprint holding
+// it, tracking the growth as a scope change. The scope-change consumer calls
+// this on every item update; same-points updates are no-ops.
+func (s *SprintService) RecordItemResized(ctx context.Context, itemID uuid.UUID, points int) error {
+	memberships, err := s.sprints.GetActiveMembershipsForItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	for _, membership := range memberships {
+		if membership.Points == points {
+			continue
+		}
+		if err := s.sprints.UpdateSprintItemPoints(ctx, membership.SprintID, itemID, points); err != nil {
+			return err
+		}
+		s.recordScopeChange(ctx, membership.SprintID, itemID, ScopeChangeResized, membership.Points, points, "")
+	}
+	return nil
+}
+
+// RecordItemDeleted removes a deleted item from every active sprint holding
+// it, tracking the removal as a scope change. The membership row itself is
+// already gone via ON DELETE CASCADE.
+func (s *SprintService) RecordItemDeleted(ctx context.Context, itemID uuid.UUID) error {
+	memberships, err := s.sprints.GetActiveMembershipsForItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	for _, membership := range memberships {
+		s.recordScopeChange(ctx, membership.SprintID, itemID, ScopeChangeRemoved, membership.Points, 0, "")
+	}
+	return nil
+}
+
+// recordScopeChange bumps the sprint's churn counters and emits the
+// scope_changed event. Tracking failures never fail the scope move itself.
+func (s *SprintService) recordScopeChange(ctx context.Context, sprintID, itemID uuid.UUID, change string, pointsBefore, pointsAfter int, actor string) {
+	added, removed := 0, 0
+	if delta := pointsAfter - pointsBefore; delta > 0 {
+		added = delta
+	} else {
+		removed = -delta
+	}
+	if err := s.sprints.AddScopeChurn(ctx, sprintID, added, removed); err != nil {
+		s.logger.Error("Failed to record scope churn", zap.Error(err))
+	}
+
+	scopeEvent := event.NewScopeChangedEvent(sprintID, itemID, change, pointsBefore, pointsAfter, actor)
+	if err := s.backlog.eventRepo.StoreEvent(ctx, scopeEvent); err != nil {
+		s.logger.Error("Failed to store scope changed event", zap.Error(err))
+	}
+	if err := s.backlog.eventPublisher.Publish(ctx, "backlog.sprint.scope_changed", scopeEvent); err != nil {
+		s.logger.Error("Failed to publish scope changed event", zap.Error(err))
+	}
+}
+
+// SprintScopeReport is one sprint's scope-churn summary
+type SprintScopeReport struct {
+	Sprint          *Sprint `json:"sprint"`
+	CommittedPoints int     `json:"committedPoints"`
+	CurrentPoints   int     `json:"currentPoints"`
+	PointsAdded     int     `json:"pointsAdded"`
+	PointsRemoved   int     `json:"pointsRemoved"`
+	NetChange       int     `json:"netChange"`
+}
+
+// GetScopeReport summarizes a sprint's committed vs. current points and the
+// churn accumulated while it was active
+func (s *SprintService) GetScopeReport(ctx context.Context, sprintID uuid.UUID) (*SprintScopeReport, error) {
+	sprint, err := s.sprints.GetSprint(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.sprints.GetSprintItems(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SprintScopeReport{
+		Sprint:        sprint,
+		PointsAdded:   sprint.PointsAdded,
+		PointsRemoved: sprint.PointsRemoved,
+		NetChange:     sprint.PointsAdded - sprint.PointsRemoved,
+	}
+	for _, membership := range memberships {
+		report.CommittedPoints += membership.PointsAtCommit
+		report.CurrentPoints += membership.Points
+	}
+
+	return report, nil
+}
+
+// services/backlog-service/internal/adapters/eventbus/scope_change_consumer.go
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// ScopeTracker is the slice of the sprint service the scope-change consumer
+// needs
+type ScopeTracker interface {
+	RecordItemResized(ctx context.Context, itemID uuid.UUID, points int) error
+	RecordItemDeleted(ctx context.Context, itemID uuid.UUID) error
+}
+
+// scopeSourceTopics are the item topics whose events can move sprint scope
+var scopeSourceTopics = []string{
+	"backlog.item.updated",
+	"backlog.item.deleted",
+}
+
+// ScopeChangeConsumer watches item updates for estimate changes on items
+// committed to active sprints, so growth inside a sprint is detected no
+// matter which code path changed the points
+type ScopeChangeConsumer struct {
+	consumer *KafkaConsumer
+	tracker  ScopeTracker
+	logger   *zap.Logger
+}
+
+// NewScopeChangeConsumer creates a new scope change consumer. One consumer
+// group is shared across replicas so each change is tracked once.
+func NewScopeChangeConsumer(cfg config.KafkaConfig, tracker ScopeTracker, logger *zap.Logger) (*ScopeChangeConsumer, error) {
+	consumer, err := NewKafkaConsumer(cfg, "backlog-scope-change-detection", logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scope change consumer: %w", err)
+	}
+	if err := consumer.Subscribe(scopeSourceTopics); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("failed to subscribe to scope source topics: %w", err)
+	}
+
+	return &ScopeChangeConsumer{
+		consumer: consumer,
+		tracker:  tracker,
+		logger:   logger,
+	}, nil
+}
+
+// scopePayload is the slice of the event payload the consumer reads
+type scopePayload struct {
+	Type   string `json:"type"`
+	ItemID string `json:"itemId"`
+	Item   *struct {
+		StoryPoints int `json:"storyPoints"`
+	} `json:"item"`
+}
+
+// Start consumes item events into scope tracking until the context is
+// cancelled
+func (c *ScopeChangeConsumer) Start(ctx context.Context) error {
+	return c.consumer.ConsumeMessages(ctx, func(message *kafka.Message) error {
+		var payload scopePayload
+		if err := json.Unmarshal(message.Value, &payload); err != nil {
+			c.logger.Error("Failed to parse scope source event", zap.Error(err))
+			return nil
+		}
+
+		itemID, err := uuid.Parse(payload.ItemID)
+		if err != nil {
+			return nil
+		}
+
+		if payload.Type == string(event.EventTypeItemDeleted) {
+			err = c.tracker.RecordItemDeleted(ctx, itemID)
+		} else if payload.Item != nil {
+			err = c.tracker.RecordItemResized(ctx, itemID, payload.Item.StoryPoints)
+		}
+		if err != nil {
+			// Returning the error leaves the offset uncommitted, so the
+			// scope change is retried rather than lost
+			c.logger.Error("Failed to track scope change",
+				zap.String("itemID", payload.ItemID),
+				zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
+
+// Close shuts the consumer down
+func (c *ScopeChangeConsumer) Close() error {
+	return c.consumer.Close()
+}
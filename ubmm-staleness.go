@@ -0,0 +1,199 @@
+// services/backlog-service/internal/domain/service/staleness.go
+
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// Staleness component weights. The score is a weighted sum of normalized
+// signals, deliberately a transparent heuristic rather than a trained model:
+// a product owner has to be able to explain why an item was flagged.
+const (
+	stalenessAgeWeight      = 0.30 // how long the item has existed
+	stalenessTouchWeight    = 0.35 // how long since anyone touched it
+	stalenessPriorityWeight = 0.20 // whether it is drifting down the backlog
+	stalenessVotesWeight    = 0.15 // absence of demand signals
+
+	// stalenessAgeHorizonDays is the age at which the age signal saturates
+	stalenessAgeHorizonDays = 365.0
+	// stalenessTouchHorizonDays is the idle time at which the last-touch
+	// signal saturates
+	stalenessTouchHorizonDays = 180.0
+
+	// defaultStalenessThreshold is the minimum score for the deletion
+	// candidate report
+	defaultStalenessThreshold = 0.5
+)
+
+// StalenessService scores the probability that an open item will never be
+// completed, from its age, idle time, priority trend and votes. The score
+// backs the deletion-candidate report that keeps an unbounded backlog honest.
+type StalenessService struct {
+	backlog *BacklogService
+	votes   VoteRepository
+	logger  *zap.Logger
+}
+
+// NewStalenessService creates a new staleness service. A nil vote repository
+// leaves the demand signal neutral.
+func NewStalenessService(backlog *BacklogService, votes VoteRepository, logger *zap.Logger) *StalenessService {
+	return &StalenessService{
+		backlog: backlog,
+		votes:   votes,
+		logger:  logger,
+	}
+}
+
+// Score computes an item's staleness score in [0, 1]. Completed and rejected
+// items score zero: they already have an outcome.
+func (s *StalenessService) Score(ctx context.Context, item *model.BacklogItem) float64 {
+	if item.Status == model.ItemStatusDone || item.Status == model.ItemStatusRejected {
+		return 0
+	}
+
+	now := time.Now().UTC()
+	ageDays := now.Sub(item.CreatedAt).Hours() / 24
+	idleDays := now.Sub(item.UpdatedAt).Hours() / 24
+
+	score := stalenessAgeWeight*clamp01(ageDays/stalenessAgeHorizonDays) +
+		stalenessTouchWeight*clamp01(idleDays/stalenessTouchHorizonDays) +
+		stalenessPriorityWeight*s.priorityTrend(ctx, item) +
+		stalenessVotesWeight*s.demandSignal(ctx, item.ID)
+
+	return clamp01(score)
+}
+
+// ScoreItem loads an item and attaches its staleness score
+func (s *StalenessService) ScoreItem(ctx context.Context, itemID uuid.UUID) (*model.BacklogItem, error) {
+	item, err := s.backlog.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.StalenessScore = s.Score(ctx, item)
+	return item, nil
+}
+
+// priorityTrend reports where the item is heading in the backlog: 1 when it
+// has drifted down since creation, 0 when it has moved up, 0.5 when flat or
+// unknown. A larger priority number is further down the backlog.
+func (s *StalenessService) priorityTrend(ctx context.Context, item *model.BacklogItem) float64 {
+	events, err := s.backlog.eventRepo.GetEventsByItemID(ctx, item.ID)
+	if err != nil {
+		s.logger.Error("Failed to load events for priority trend",
+			zap.String("itemID", item.ID.String()),
+			zap.Error(err))
+		return 0.5
+	}
+
+	for _, e := range events {
+		created, ok := e.(*event.ItemCreatedEvent)
+		if !ok || created.Item == nil {
+			continue
+		}
+		switch {
+		case item.Priority > created.Item.Priority:
+			return 1
+		case item.Priority < created.Item.Priority:
+			return 0
+		}
+		return 0.5
+	}
+
+	return 0.5
+}
+
+// demandSignal maps votes to staleness: no votes is 1, heavy demand
+// approaches 0
+func (s *StalenessService) demandSignal(ctx context.Context, itemID uuid.UUID) float64 {
+	if s.votes == nil {
+		return 0.5
+	}
+
+	summary, err := s.votes.Summary(ctx, itemID)
+	if err != nil {
+		s.logger.Error("Failed to load vote summary for staleness",
+			zap.String("itemID", itemID.String()),
+			zap.Error(err))
+		return 0.5
+	}
+
+	return 1 / float64(1+summary.WeightedVotes)
+}
+
+// StaleCandidate is one item flagged as likely never to complete, with the
+// raw signals behind the score so reviewers can sanity-check it
+type StaleCandidate struct {
+	Item           *model.BacklogItem `json:"item"`
+	StalenessScore float64            `json:"stalenessScore"`
+	AgeDays        float64            `json:"ageDays"`
+	IdleDays       float64            `json:"idleDays"`
+}
+
+// GetDeletionCandidates scores every open pre-commitment item and returns
+// the top candidates above the threshold, stalest first. A threshold of 0
+// uses the default; limit caps the report size.
+func (s *StalenessService) GetDeletionCandidates(ctx context.Context, threshold float64, limit int) ([]*StaleCandidate, error) {
+	if threshold <= 0 {
+		threshold = defaultStalenessThreshold
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	// Items already being worked on are not deletion candidates, however old
+	items, _, err := s.backlog.repo.List(ctx, repository.BacklogFilter{
+		Statuses: []model.ItemStatus{model.ItemStatusIntake, model.ItemStatusNew, model.ItemStatusReady},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var candidates []*StaleCandidate
+	for _, item := range items {
+		score := s.Score(ctx, item)
+		if score < threshold {
+			continue
+		}
+
+		item.StalenessScore = score
+		candidates = append(candidates, &StaleCandidate{
+			Item:           item,
+			StalenessScore: score,
+			AgeDays:        now.Sub(item.CreatedAt).Hours() / 24,
+			IdleDays:       now.Sub(item.UpdatedAt).Hours() / 24,
+		})
+	}
+
+	// Stalest first, so the strongest candidates lead the report
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].StalenessScore > candidates[j].StalenessScore
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates, nil
+}
+
+// clamp01 clamps a value into [0, 1]
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
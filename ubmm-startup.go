@@ -0,0 +1,206 @@
+// services/backlog-service/internal/lifecycle/startup.go
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Dependency states reported by the initialization status endpoint
+const (
+	// StatePending means initialization has not reached the dependency yet
+	StatePending = "pending"
+	// StateReady means the dependency initialized successfully
+	StateReady = "ready"
+	// StateDegraded means an optional dependency failed and the service
+	// started without it
+	StateDegraded = "degraded"
+	// StateFailed means a required dependency failed and startup aborted
+	StateFailed = "failed"
+)
+
+// maxInitBackoff caps the wait between initialization attempts
+const maxInitBackoff = 15 * time.Second
+
+// InitFunc initializes one dependency; it is retried with backoff until it
+// succeeds or the dependency's wait budget runs out
+type InitFunc func(ctx context.Context) error
+
+// dependency tracks one registered dependency through initialization
+type dependency struct {
+	name     string
+	required bool
+	init     InitFunc
+
+	state    string
+	attempts int
+	lastErr  string
+	readyAt  *time.Time
+}
+
+// Initializer brings dependencies up in registration order, retrying each
+// with backoff instead of failing hard on a briefly unavailable Postgres or
+// Kafka. Optional dependencies that never come up leave the service in
+// degraded mode rather than blocking startup.
+type Initializer struct {
+	maxWait time.Duration
+	logger  *zap.Logger
+
+	mu   sync.Mutex
+	deps []*dependency
+}
+
+// NewInitializer creates a new initializer. maxWait bounds the retry budget
+// for each dependency.
+func NewInitializer(maxWait time.Duration, logger *zap.Logger) *Initializer {
+	return &Initializer{
+		maxWait: maxWait,
+		logger:  logger,
+	}
+}
+
+// Register adds a dependency to initialize. Dependencies initialize in
+// registration order; optional ones (required false) degrade instead of
+// aborting startup when they stay down.
+func (i *Initializer) Register(name string, required bool, init InitFunc) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.deps = append(i.deps, &dependency{
+		name:     name,
+		required: required,
+		init:     init,
+		state:    StatePending,
+	})
+}
+
+// Run initializes every registered dependency and returns an error only
+// when a required dependency stays down past its wait budget
+func (i *Initializer) Run(ctx context.Context) error {
+	for _, dep := range i.deps {
+		if err := i.bringUp(ctx, dep); err != nil {
+			if dep.required {
+				i.setState(dep, StateFailed, err)
+				return fmt.Errorf("required dependency %s failed to initialize: %w", dep.name, err)
+			}
+			i.setState(dep, StateDegraded, err)
+			i.logger.Warn("Starting degraded without optional dependency",
+				zap.String("dependency", dep.name),
+				zap.Error(err))
+			continue
+		}
+		i.setState(dep, StateReady, nil)
+		i.logger.Info("Dependency ready", zap.String("dependency", dep.name))
+	}
+	return nil
+}
+
+// bringUp retries one dependency with exponential backoff until it succeeds
+// or the wait budget runs out
+func (i *Initializer) bringUp(ctx context.Context, dep *dependency) error {
+	deadline := time.Now().Add(i.maxWait)
+	backoff := time.Second
+
+	for {
+		i.mu.Lock()
+		dep.attempts++
+		i.mu.Unlock()
+
+		err := dep.init(ctx)
+		if err == nil {
+			return nil
+		}
+
+		i.mu.Lock()
+		dep.lastErr = err.Error()
+		i.mu.Unlock()
+
+		if time.Now().Add(backoff).After(deadline) {
+			return err
+		}
+		i.logger.Warn("Dependency not ready, retrying",
+			zap.String("dependency", dep.name),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxInitBackoff {
+			backoff = maxInitBackoff
+		}
+	}
+}
+
+// setState records a dependency's final state
+func (i *Initializer) setState(dep *dependency, state string, err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	dep.state = state
+	if err != nil {
+		dep.lastErr = err.Error()
+	}
+	if state == StateReady {
+		now := time.Now()
+		dep.readyAt = &now
+	}
+}
+
+// Degraded reports whether any optional dependency failed to come up
+func (i *Initializer) Degraded() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, dep := range i.deps {
+		if dep.state == StateDegraded {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyStatus is one dependency's line in the status endpoint response
+type dependencyStatus struct {
+	Name     string     `json:"name"`
+	Required bool       `json:"required"`
+	State    string     `json:"state"`
+	Attempts int        `json:"attempts"`
+	Error    string     `json:"error,omitempty"`
+	ReadyAt  *time.Time `json:"readyAt,omitempty"`
+}
+
+// Handler returns the HTTP handler for the initialization status endpoint
+func (i *Initializer) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		i.mu.Lock()
+		response := struct {
+			Degraded     bool               `json:"degraded"`
+			Dependencies []dependencyStatus `json:"dependencies"`
+		}{}
+		for _, dep := range i.deps {
+			if dep.state == StateDegraded {
+				response.Degraded = true
+			}
+			response.Dependencies = append(response.Dependencies, dependencyStatus{
+				Name:     dep.name,
+				Required: dep.required,
+				State:    dep.state,
+				Attempts: dep.attempts,
+				Error:    dep.lastErr,
+				ReadyAt:  dep.readyAt,
+			})
+		}
+		i.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}
+}
@@ -0,0 +1,105 @@
+// services/backlog-service/internal/adapters/eventbus/state_topic.go
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/config"
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// StateTopic is the compacted topic carrying the latest full state of every
+// item, keyed by item ID. Create it with cleanup.policy=compact so Kafka
+// retains exactly one record per item; new consumers bootstrap current state
+// by reading it from the beginning, without hitting the API.
+const StateTopic = "backlog.items.state"
+
+// stateSourceTopics are the change topics whose payloads carry the full item
+// state the compacted topic republishes
+var stateSourceTopics = []string{
+	"backlog.item.created",
+	"backlog.item.updated",
+	"backlog.item.deleted",
+	"backlog.item.published",
+	"backlog.item.reverted",
+}
+
+// StateTopicProjector keeps the compacted state topic in step with the
+// change topics: every change becomes an upsert keyed by item ID, every
+// delete becomes a tombstone that compaction eventually removes
+type StateTopicProjector struct {
+	consumer *KafkaConsumer
+	producer event.KafkaProducer
+	logger   *zap.Logger
+}
+
+// NewStateTopicProjector creates a new state topic projector. One consumer
+// group is shared across replicas so each change is republished once.
+func NewStateTopicProjector(cfg config.KafkaConfig, producer event.KafkaProducer, logger *zap.Logger) (*StateTopicProjector, error) {
+	consumer, err := NewKafkaConsumer(cfg, "backlog-state-topic-projection", logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create state topic projector: %w", err)
+	}
+	if err := consumer.Subscribe(stateSourceTopics); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("failed to subscribe to state source topics: %w", err)
+	}
+
+	return &StateTopicProjector{
+		consumer: consumer,
+		producer: producer,
+		logger:   logger,
+	}, nil
+}
+
+// statePayload is the slice of the event payload the projector reads
+type statePayload struct {
+	Type   string          `json:"type"`
+	ItemID string          `json:"itemId"`
+	Item   json.RawMessage `json:"item"`
+}
+
+// Start projects change events onto the state topic until the context is
+// cancelled
+func (p *StateTopicProjector) Start(ctx context.Context) error {
+	return p.consumer.ConsumeMessages(ctx, func(message *kafka.Message) error {
+		var payload statePayload
+		if err := json.Unmarshal(message.Value, &payload); err != nil {
+			p.logger.Error("Failed to parse state source event", zap.Error(err))
+			return nil
+		}
+		if payload.ItemID == "" {
+			return nil
+		}
+
+		// Deletes become tombstones so compaction drops the item entirely
+		var value []byte
+		if payload.Type != string(event.EventTypeItemDeleted) {
+			if len(payload.Item) == 0 {
+				return nil
+			}
+			value = payload.Item
+		}
+
+		if err := p.producer.Send(ctx, StateTopic, payload.ItemID, value); err != nil {
+			// Returning the error leaves the offset uncommitted, so the
+			// state record is retried rather than lost
+			p.logger.Error("Failed to publish state record",
+				zap.String("itemID", payload.ItemID),
+				zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
+
+// Close shuts the projector down
+func (p *StateTopicProjector) Close() error {
+	return p.consumer.Close()
+}
@@ -0,0 +1,55 @@
+-- services/backlog-service/migrations/000007_status_timestamps.up.sql
+
+-- Lead time used to be approximated from updated_at, which moves on every
+-- edit. Persist the transition timestamps on the row instead; the domain
+-- layer maintains them on every status change.
+ALTER TABLE backlog_items ADD COLUMN started_at TIMESTAMPTZ;
+ALTER TABLE backlog_items ADD COLUMN completed_at TIMESTAMPTZ;
+ALTER TABLE backlog_items ADD COLUMN blocked_since TIMESTAMPTZ;
+
+-- Backfill from the event log: first transition into IN_PROGRESS, last
+-- transition into DONE, and last transition into BLOCKED for items still
+-- blocked today.
+UPDATE backlog_items b
+SET started_at = e.first_in_progress
+FROM (
+    SELECT item_id, MIN(created_at) AS first_in_progress
+    FROM events
+    WHERE payload->'item'->>'status' = 'IN_PROGRESS'
+    GROUP BY item_id
+) e
+WHERE b.id = e.item_id;
+
+UPDATE backlog_items b
+SET completed_at = e.last_done
+FROM (
+    SELECT item_id, MAX(created_at) AS last_done
+    FROM events
+    WHERE payload->'item'->>'status' = 'DONE'
+    GROUP BY item_id
+) e
+WHERE b.id = e.item_id AND b.status = 'DONE';
+
+UPDATE backlog_items b
+SET blocked_since = e.last_blocked
+FROM (
+    SELECT item_id, MAX(created_at) AS last_blocked
+    FROM events
+    WHERE payload->'item'->>'status' = 'BLOCKED'
+    GROUP BY item_id
+) e
+WHERE b.id = e.item_id AND b.status = 'BLOCKED';
+
+-- Items done or blocked before event sourcing existed fall back to updated_at
+UPDATE backlog_items SET completed_at = updated_at WHERE status = 'DONE' AND completed_at IS NULL;
+UPDATE backlog_items SET blocked_since = updated_at WHERE status = 'BLOCKED' AND blocked_since IS NULL;
+
+-- Completion-window queries drive lead time and throughput metrics
+CREATE INDEX idx_backlog_items_completed_at ON backlog_items(completed_at) WHERE completed_at IS NOT NULL;
+
+-- services/backlog-service/migrations/000007_status_timestamps.down.sql
+
+DROP INDEX IF EXISTS idx_backlog_items_completed_at;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS blocked_since;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE backlog_items DROP COLUMN IF EXISTS started_at;
@@ -0,0 +1,189 @@
+// services/backlog-service/internal/domain/sync/conflict.go
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+)
+
+// Policy defines how a field conflict between UBMM and an external system
+// is resolved
+type Policy string
+
+const (
+	// PolicyOurs keeps the UBMM value
+	PolicyOurs Policy = "OURS"
+	// PolicyTheirs takes the external system's value
+	PolicyTheirs Policy = "THEIRS"
+	// PolicyNewest takes whichever side changed most recently
+	PolicyNewest Policy = "NEWEST"
+	// PolicyManual queues the conflict for a human decision
+	PolicyManual Policy = "MANUAL"
+)
+
+// FieldChange describes one side of a conflicting field edit
+type FieldChange struct {
+	Field     string    `json:"field"`
+	Value     string    `json:"value"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// Conflict represents a detected conflict awaiting (or after) resolution
+type Conflict struct {
+	ID         uuid.UUID   `json:"id"`
+	ItemID     uuid.UUID   `json:"itemId"`
+	System     string      `json:"system"`
+	Field      string      `json:"field"`
+	Ours       FieldChange `json:"ours"`
+	Theirs     FieldChange `json:"theirs"`
+	Resolution Policy      `json:"resolution,omitempty"` // empty while pending
+	ResolvedBy string      `json:"resolvedBy,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	ResolvedAt *time.Time  `json:"resolvedAt,omitempty"`
+}
+
+// ConflictRepository defines the interface for the conflicts queue
+type ConflictRepository interface {
+	// Create stores a new pending conflict
+	Create(ctx context.Context, conflict *Conflict) error
+
+	// GetByID retrieves a conflict by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Conflict, error)
+
+	// ListPending retrieves unresolved conflicts, optionally scoped to an item
+	ListPending(ctx context.Context, itemID *uuid.UUID, limit, offset int) ([]*Conflict, error)
+
+	// Update stores a resolved conflict
+	Update(ctx context.Context, conflict *Conflict) error
+}
+
+// Resolution is the outcome the resolver returns to the calling connector
+type Resolution struct {
+	// Winner is the value that should be written; unset for queued conflicts
+	Winner *FieldChange
+
+	// Queued reports that the conflict was queued for manual resolution
+	Queued bool
+}
+
+// Resolver applies per-field conflict policies and maintains the manual
+// conflicts queue
+type Resolver struct {
+	policies      map[string]Policy
+	defaultPolicy Policy
+	conflicts     ConflictRepository
+	publisher     event.Publisher
+	logger        *zap.Logger
+}
+
+// NewResolver creates a new conflict resolver. policies maps field names to
+// policies; fields without an entry use defaultPolicy.
+func NewResolver(policies map[string]Policy, defaultPolicy Policy, conflicts ConflictRepository, publisher event.Publisher, logger *zap.Logger) *Resolver {
+	if defaultPolicy == "" {
+		defaultPolicy = PolicyNewest
+	}
+	return &Resolver{
+		policies:      policies,
+		defaultPolicy: defaultPolicy,
+		conflicts:     conflicts,
+		publisher:     publisher,
+		logger:        logger,
+	}
+}
+
+// Resolve decides the winner for a conflicting field edit. For MANUAL fields
+// the conflict is queued and no winner is returned.
+func (r *Resolver) Resolve(ctx context.Context, itemID uuid.UUID, system string, ours, theirs FieldChange) (*Resolution, error) {
+	policy, ok := r.policies[ours.Field]
+	if !ok {
+		policy = r.defaultPolicy
+	}
+
+	switch policy {
+	case PolicyOurs:
+		return &Resolution{Winner: &ours}, nil
+	case PolicyTheirs:
+		return &Resolution{Winner: &theirs}, nil
+	case PolicyNewest:
+		if theirs.ChangedAt.After(ours.ChangedAt) {
+			return &Resolution{Winner: &theirs}, nil
+		}
+		return &Resolution{Winner: &ours}, nil
+	case PolicyManual:
+		conflict := &Conflict{
+			ID:        uuid.New(),
+			ItemID:    itemID,
+			System:    system,
+			Field:     ours.Field,
+			Ours:      ours,
+			Theirs:    theirs,
+			CreatedAt: time.Now().UTC(),
+		}
+		if err := r.conflicts.Create(ctx, conflict); err != nil {
+			return nil, fmt.Errorf("failed to queue conflict: %w", err)
+		}
+
+		// Publish for visibility in dashboards and notifications
+		conflictEvent := event.NewSyncConflictDetectedEvent(itemID, system, ours.Field)
+		if err := r.publisher.Publish(ctx, "backlog.sync.conflict.detected", conflictEvent); err != nil {
+			r.logger.Error("Failed to publish conflict detected event", zap.Error(err))
+		}
+
+		return &Resolution{Queued: true}, nil
+	default:
+		return nil, fmt.Errorf("unknown conflict policy %q for field %q", policy, ours.Field)
+	}
+}
+
+// ResolveManually resolves a queued conflict with an explicit choice. choice
+// must be OURS or THEIRS; the winning value is returned so the caller can
+// apply it.
+func (r *Resolver) ResolveManually(ctx context.Context, conflictID uuid.UUID, choice Policy, resolvedBy string) (*FieldChange, error) {
+	if choice != PolicyOurs && choice != PolicyTheirs {
+		return nil, fmt.Errorf("manual resolution must choose OURS or THEIRS")
+	}
+
+	conflict, err := r.conflicts.GetByID(ctx, conflictID)
+	if err != nil {
+		return nil, err
+	}
+	if conflict.ResolvedAt != nil {
+		return nil, fmt.Errorf("conflict %s is already resolved", conflictID)
+	}
+
+	now := time.Now().UTC()
+	conflict.Resolution = choice
+	conflict.ResolvedBy = resolvedBy
+	conflict.ResolvedAt = &now
+	if err := r.conflicts.Update(ctx, conflict); err != nil {
+		return nil, fmt.Errorf("failed to store conflict resolution: %w", err)
+	}
+
+	winner := conflict.Ours
+	if choice == PolicyTheirs {
+		winner = conflict.Theirs
+	}
+
+	// Publish for visibility
+	resolvedEvent := event.NewSyncConflictResolvedEvent(conflict.ItemID, conflict.System, conflict.Field, string(choice))
+	if err := r.publisher.Publish(ctx, "backlog.sync.conflict.resolved", resolvedEvent); err != nil {
+		r.logger.Error("Failed to publish conflict resolved event", zap.Error(err))
+	}
+
+	return &winner, nil
+}
+
+// ListPending lists unresolved conflicts for the resolution API
+func (r *Resolver) ListPending(ctx context.Context, itemID *uuid.UUID, limit, offset int) ([]*Conflict, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	return r.conflicts.ListPending(ctx, itemID, limit, offset)
+}
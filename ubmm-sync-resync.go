@@ -0,0 +1,145 @@
+// services/backlog-service/internal/domain/integration/resync.go
+
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// SyncJob is a queued request to re-sync one item through one connector
+type SyncJob struct {
+	ItemID    uuid.UUID `json:"itemId"`
+	Connector string    `json:"connector"`
+}
+
+// JobQueue enqueues sync jobs for the background sync workers
+type JobQueue interface {
+	// Enqueue adds a sync job to the queue
+	Enqueue(ctx context.Context, job SyncJob) error
+}
+
+// ItemSyncView is the sync block attached to item payloads, one entry per
+// connected system
+type ItemSyncView struct {
+	Statuses []*SyncStatus `json:"syncStatuses"`
+}
+
+// ResyncService exposes sync state on items and lets admins re-enqueue sync
+// jobs to recover from integration outages
+type ResyncService struct {
+	registry *Registry
+	repo     repository.BacklogRepository
+	queue    JobQueue
+	logger   *zap.Logger
+}
+
+// NewResyncService creates a new resync service
+func NewResyncService(registry *Registry, repo repository.BacklogRepository, queue JobQueue, logger *zap.Logger) *ResyncService {
+	return &ResyncService{
+		registry: registry,
+		repo:     repo,
+		queue:    queue,
+		logger:   logger,
+	}
+}
+
+// GetItemSyncView returns the per-connector sync state for an item, for
+// inclusion in the item payload
+func (s *ResyncService) GetItemSyncView(ctx context.Context, itemID uuid.UUID) (*ItemSyncView, error) {
+	statuses, err := s.registry.ItemStatus(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sync statuses: %w", err)
+	}
+	return &ItemSyncView{Statuses: statuses}, nil
+}
+
+// ResyncItem enqueues sync jobs for one item. With an empty connector it
+// re-syncs the item on every registered connector.
+func (s *ResyncService) ResyncItem(ctx context.Context, itemID uuid.UUID, connector string) error {
+	// Make sure the item exists before enqueueing work
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return err
+	}
+
+	connectors := s.registry.Names()
+	if connector != "" {
+		if _, err := s.registry.Get(connector); err != nil {
+			return err
+		}
+		connectors = []string{connector}
+	}
+
+	for _, name := range connectors {
+		if err := s.queue.Enqueue(ctx, SyncJob{ItemID: itemID, Connector: name}); err != nil {
+			return fmt.Errorf("failed to enqueue sync job for %s: %w", name, err)
+		}
+	}
+
+	s.logger.Info("Resync enqueued",
+		zap.String("itemId", itemID.String()),
+		zap.Strings("connectors", connectors))
+
+	return nil
+}
+
+// ResyncAll enqueues sync jobs for every item matching the filter, paging
+// through the repository to bound memory use. It returns the number of items
+// enqueued.
+func (s *ResyncService) ResyncAll(ctx context.Context, filter repository.BacklogFilter, connector string) (int, error) {
+	if connector != "" {
+		if _, err := s.registry.Get(connector); err != nil {
+			return 0, err
+		}
+	}
+
+	const pageSize = 200
+	filter.Limit = pageSize
+	filter.Offset = 0
+
+	enqueued := 0
+	for {
+		items, _, err := s.repo.List(ctx, filter)
+		if err != nil {
+			return enqueued, fmt.Errorf("failed to list items for resync: %w", err)
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			if err := s.enqueueForItem(ctx, item, connector); err != nil {
+				return enqueued, err
+			}
+			enqueued++
+		}
+
+		filter.Offset += pageSize
+	}
+
+	s.logger.Info("Bulk resync enqueued",
+		zap.Int("items", enqueued),
+		zap.String("connector", connector))
+
+	return enqueued, nil
+}
+
+// enqueueForItem enqueues jobs for one item on the selected connectors
+func (s *ResyncService) enqueueForItem(ctx context.Context, item *model.BacklogItem, connector string) error {
+	connectors := s.registry.Names()
+	if connector != "" {
+		connectors = []string{connector}
+	}
+	for _, name := range connectors {
+		if err := s.queue.Enqueue(ctx, SyncJob{ItemID: item.ID, Connector: name}); err != nil {
+			return fmt.Errorf("failed to enqueue sync job for %s: %w", name, err)
+		}
+	}
+	return nil
+}
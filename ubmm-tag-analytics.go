@@ -0,0 +1,185 @@
+// services/backlog-service/internal/domain/service/tag_analytics.go
+
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// tagAnalyticsCacheKey is where the latest tag analytics snapshot lives
+const tagAnalyticsCacheKey = "tag_analytics"
+
+// TagUsage is how often one tag is used across the backlog
+type TagUsage struct {
+	Tag         string    `json:"tag"`
+	Count       int       `json:"count"`
+	RecentCount int       `json:"recentCount"` // usages on items created in the last 30 days
+	LastUsed    time.Time `json:"lastUsed"`    // creation time of the newest item carrying the tag
+}
+
+// TagCoOccurrence is how often two tags appear on the same item. TagA sorts
+// before TagB so each pair appears once.
+type TagCoOccurrence struct {
+	TagA  string `json:"tagA"`
+	TagB  string `json:"tagB"`
+	Count int    `json:"count"`
+}
+
+// TagAnalyticsReport is a snapshot of how the workspace's label taxonomy is
+// used, for pruning stale or near-duplicate tags
+type TagAnalyticsReport struct {
+	ComputedAt    time.Time          `json:"computedAt"`
+	Usage         []*TagUsage        `json:"usage"`         // most used first
+	CoOccurrences []*TagCoOccurrence `json:"coOccurrences"` // most frequent pairs first
+	OrphanedTags  []string           `json:"orphanedTags"`  // tags used by exactly one item
+}
+
+// TagAnalyticsJob recomputes the tag analytics snapshot on a schedule. The
+// snapshot is a projection: reads are served from the cached copy, so the
+// full-backlog scan happens once a day, not per request.
+type TagAnalyticsJob struct {
+	backlog *BacklogService
+	logger  *zap.Logger
+}
+
+// NewTagAnalyticsJob creates a new tag analytics job
+func NewTagAnalyticsJob(backlog *BacklogService, logger *zap.Logger) *TagAnalyticsJob {
+	return &TagAnalyticsJob{
+		backlog: backlog,
+		logger:  logger,
+	}
+}
+
+// Run recomputes the snapshot from the full backlog and caches it
+func (j *TagAnalyticsJob) Run(ctx context.Context) error {
+	report, err := j.compute(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := j.backlog.cache.Set(ctx, tagAnalyticsCacheKey, report, 25*time.Hour); err != nil {
+		j.logger.Error("Failed to cache tag analytics", zap.Error(err))
+	}
+
+	j.logger.Info("Tag analytics job finished",
+		zap.Int("tags", len(report.Usage)),
+		zap.Int("orphaned", len(report.OrphanedTags)))
+	return nil
+}
+
+// Start runs the job daily until the context is cancelled
+func (j *TagAnalyticsJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil {
+				j.logger.Error("Tag analytics job failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// compute builds the report in one pass over the backlog
+func (j *TagAnalyticsJob) compute(ctx context.Context) (*TagAnalyticsReport, error) {
+	usage := make(map[string]*TagUsage)
+	pairs := make(map[[2]string]int)
+	recentCutoff := time.Now().UTC().AddDate(0, 0, -30)
+
+	const pageSize = 200
+	filter := repository.BacklogFilter{Limit: pageSize}
+	for {
+		items, _, err := j.backlog.repo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			for _, tag := range item.Tags {
+				entry, ok := usage[tag]
+				if !ok {
+					entry = &TagUsage{Tag: tag}
+					usage[tag] = entry
+				}
+				entry.Count++
+				if item.CreatedAt.After(recentCutoff) {
+					entry.RecentCount++
+				}
+				if item.CreatedAt.After(entry.LastUsed) {
+					entry.LastUsed = item.CreatedAt
+				}
+			}
+
+			// Count each unordered tag pair once per item
+			for i := 0; i < len(item.Tags); i++ {
+				for k := i + 1; k < len(item.Tags); k++ {
+					a, b := item.Tags[i], item.Tags[k]
+					if a > b {
+						a, b = b, a
+					}
+					pairs[[2]string{a, b}]++
+				}
+			}
+		}
+
+		filter.Offset += pageSize
+	}
+
+	report := &TagAnalyticsReport{ComputedAt: time.Now().UTC()}
+	for _, entry := range usage {
+		report.Usage = append(report.Usage, entry)
+		if entry.Count == 1 {
+			report.OrphanedTags = append(report.OrphanedTags, entry.Tag)
+		}
+	}
+	for pair, count := range pairs {
+		report.CoOccurrences = append(report.CoOccurrences, &TagCoOccurrence{
+			TagA:  pair[0],
+			TagB:  pair[1],
+			Count: count,
+		})
+	}
+
+	sort.Slice(report.Usage, func(i, k int) bool {
+		return report.Usage[i].Count > report.Usage[k].Count
+	})
+	sort.Slice(report.CoOccurrences, func(i, k int) bool {
+		return report.CoOccurrences[i].Count > report.CoOccurrences[k].Count
+	})
+	sort.Strings(report.OrphanedTags)
+
+	return report, nil
+}
+
+// GetTagAnalytics returns the latest cached snapshot, computing one on the
+// spot only when no run has happened yet
+func (j *TagAnalyticsJob) GetTagAnalytics(ctx context.Context) (*TagAnalyticsReport, error) {
+	cached, err := j.backlog.cache.Get(ctx, tagAnalyticsCacheKey)
+	if err == nil && cached != nil {
+		if report, ok := cached.(*TagAnalyticsReport); ok {
+			return report, nil
+		}
+	}
+
+	report, err := j.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.backlog.cache.Set(ctx, tagAnalyticsCacheKey, report, 25*time.Hour); err != nil {
+		j.logger.Error("Failed to cache tag analytics", zap.Error(err))
+	}
+	return report, nil
+}
@@ -0,0 +1,215 @@
+// services/backlog-service/internal/domain/service/tag_rename.go
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// tagEventChunkSize caps how many item IDs one tag event carries, so bulk
+// renames over large backlogs never produce an unbounded payload
+const tagEventChunkSize = 100
+
+// RenameTag renames a tag on every item carrying it and emits chunked
+// tag.renamed events listing the affected item IDs, so downstream systems
+// holding the old tag can follow along instead of drifting.
+func (s *BacklogService) RenameTag(ctx context.Context, oldTag, newTag string) (int, error) {
+	if oldTag == "" || newTag == "" {
+		return 0, errors.New("both the old and new tag are required")
+	}
+	if oldTag == newTag {
+		return 0, errors.New("old and new tag are the same")
+	}
+
+	affected, err := s.retagItems(ctx, []string{oldTag}, newTag)
+	if err != nil {
+		return 0, err
+	}
+
+	s.publishTagChunks(ctx, affected, func(chunk []uuid.UUID, index, total int) (string, interface{}) {
+		return "backlog.tag.renamed", event.NewTagRenamedEvent(oldTag, newTag, chunk, index, total)
+	})
+
+	s.invalidateListCache(ctx)
+	return len(affected), nil
+}
+
+// MergeTags replaces several source tags with one target tag across the
+// backlog and emits chunked tag.merged events
+func (s *BacklogService) MergeTags(ctx context.Context, sourceTags []string, targetTag string) (int, error) {
+	if len(sourceTags) == 0 || targetTag == "" {
+		return 0, errors.New("source tags and a target tag are required")
+	}
+	for _, source := range sourceTags {
+		if source == targetTag {
+			return 0, errors.New("target tag cannot be one of the source tags")
+		}
+	}
+
+	affected, err := s.retagItems(ctx, sourceTags, targetTag)
+	if err != nil {
+		return 0, err
+	}
+
+	s.publishTagChunks(ctx, affected, func(chunk []uuid.UUID, index, total int) (string, interface{}) {
+		return "backlog.tag.merged", event.NewTagsMergedEvent(sourceTags, targetTag, chunk, index, total)
+	})
+
+	s.invalidateListCache(ctx)
+	return len(affected), nil
+}
+
+// retagItems replaces the given tags with the replacement on every item
+// carrying any of them and returns the affected item IDs
+func (s *BacklogService) retagItems(ctx context.Context, tags []string, replacement string) ([]uuid.UUID, error) {
+	var affected []uuid.UUID
+
+	for _, tag := range tags {
+		const pageSize = 200
+		filter := repository.BacklogFilter{
+			Tags:          []string{tag},
+			Limit:         pageSize,
+			IncludeDrafts: true,
+		}
+		for {
+			// Always read the first page: each update removes the matched
+			// tag, so the remaining matches shift forward
+			items, _, err := s.repo.List(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			if len(items) == 0 {
+				break
+			}
+
+			for _, item := range items {
+				item.RemoveTag(tag)
+				item.AddTag(replacement)
+				if err := s.repo.Update(ctx, item); err != nil {
+					return nil, err
+				}
+				s.cache.Delete(ctx, "item:"+item.ID.String())
+				affected = append(affected, item.ID)
+			}
+		}
+	}
+
+	return affected, nil
+}
+
+// publishTagChunks splits the affected IDs into chunks and publishes one
+// event per chunk via build, which returns the topic and event payload
+func (s *BacklogService) publishTagChunks(ctx context.Context, affected []uuid.UUID, build func(chunk []uuid.UUID, index, total int) (string, interface{})) {
+	total := (len(affected) + tagEventChunkSize - 1) / tagEventChunkSize
+	if total == 0 {
+		total = 1
+	}
+
+	for index := 0; index < total; index++ {
+		start := index * tagEventChunkSize
+		end := start + tagEventChunkSize
+		if end > len(affected) {
+			end = len(affected)
+		}
+
+		topic, chunkEvent := build(affected[start:end], index+1, total)
+		if err := s.eventRepo.StoreEvent(ctx, chunkEvent); err != nil {
+			s.logger.Error("Failed to store tag event", zap.Error(err))
+		}
+		if err := s.eventPublisher.Publish(ctx, topic, chunkEvent); err != nil {
+			s.logger.Error("Failed to publish tag event", zap.Error(err))
+		}
+	}
+}
+
+// services/backlog-service/pkg/client/tag_events.go
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TagRenamed is one chunk of a backlog.tag.renamed event as consumers
+// receive it off the bus
+type TagRenamed struct {
+	OldTag      string   `json:"oldTag"`
+	NewTag      string   `json:"newTag"`
+	ItemIDs     []string `json:"itemIds"`
+	Chunk       int      `json:"chunk"`
+	TotalChunks int      `json:"totalChunks"`
+}
+
+// TagsMerged is one chunk of a backlog.tag.merged event
+type TagsMerged struct {
+	SourceTags  []string `json:"sourceTags"`
+	TargetTag   string   `json:"targetTag"`
+	ItemIDs     []string `json:"itemIds"`
+	Chunk       int      `json:"chunk"`
+	TotalChunks int      `json:"totalChunks"`
+}
+
+// ParseTagRenamed decodes a backlog.tag.renamed payload
+func ParseTagRenamed(payload []byte) (*TagRenamed, error) {
+	var renamed TagRenamed
+	if err := json.Unmarshal(payload, &renamed); err != nil {
+		return nil, fmt.Errorf("failed to parse tag renamed event: %w", err)
+	}
+	return &renamed, nil
+}
+
+// ParseTagsMerged decodes a backlog.tag.merged payload
+func ParseTagsMerged(payload []byte) (*TagsMerged, error) {
+	var merged TagsMerged
+	if err := json.Unmarshal(payload, &merged); err != nil {
+		return nil, fmt.Errorf("failed to parse tags merged event: %w", err)
+	}
+	return &merged, nil
+}
+
+// ApplyRename applies a tag rename to a locally held tag list and returns
+// the updated list. Consumers call it for each item ID in the chunk they
+// track themselves.
+func ApplyRename(tags []string, renamed *TagRenamed) []string {
+	return replaceTags(tags, []string{renamed.OldTag}, renamed.NewTag)
+}
+
+// ApplyMerge applies a tag merge to a locally held tag list
+func ApplyMerge(tags []string, merged *TagsMerged) []string {
+	return replaceTags(tags, merged.SourceTags, merged.TargetTag)
+}
+
+// replaceTags removes the old tags and adds the replacement once,
+// preserving the order of the untouched tags
+func replaceTags(tags []string, oldTags []string, replacement string) []string {
+	remove := make(map[string]bool, len(oldTags))
+	for _, tag := range oldTags {
+		remove[tag] = true
+	}
+
+	result := make([]string, 0, len(tags))
+	matched := false
+	hasReplacement := false
+	for _, tag := range tags {
+		if remove[tag] {
+			matched = true
+			continue
+		}
+		if tag == replacement {
+			hasReplacement = true
+		}
+		result = append(result, tag)
+	}
+	if matched && !hasReplacement {
+		result = append(result, replacement)
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+// services/backlog-service/internal/adapters/eventbus/tenant.go
+
+package eventbus
+
+import (
+	"context"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+)
+
+// TenantHeaderKey is the Kafka message header carrying the workspace ID, so
+// consumers get tenant context without parsing payloads
+const TenantHeaderKey = "workspace-id"
+
+// tenantContextKey is the context key type for the publishing tenant
+type tenantContextKey string
+
+const tenantKey tenantContextKey = "eventbus_workspace_id"
+
+// WithTenant attaches a workspace ID to a context so publishes carry it in
+// the message key and headers. The gRPC middleware sets this alongside the
+// row-level security identity.
+func WithTenant(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, tenantKey, workspaceID)
+}
+
+// TenantFromContext extracts the workspace ID from a context; an empty
+// string means no tenant was set
+func TenantFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(tenantKey).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// TenantFromMessage extracts the workspace ID from a message's headers; an
+// empty string means the message predates tenant routing
+func TenantFromMessage(msg *kafka.Message) string {
+	for _, header := range msg.Headers {
+		if header.Key == TenantHeaderKey {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// TenantFilteringHandler wraps a message handler so it only sees messages
+// for one workspace. Messages for other tenants are acknowledged without
+// processing; messages without a tenant header are passed through so
+// consumers keep working during the rollout.
+func TenantFilteringHandler(workspaceID string, next MessageHandler, logger *zap.Logger) MessageHandler {
+	return func(msg *kafka.Message) error {
+		tenant := TenantFromMessage(msg)
+		if tenant != "" && tenant != workspaceID {
+			logger.Debug("Skipped message for other tenant",
+				zap.String("topic", *msg.TopicPartition.Topic),
+				zap.String("tenant", tenant))
+			return nil
+		}
+		return next(msg)
+	}
+}
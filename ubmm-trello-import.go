@@ -0,0 +1,201 @@
+// services/backlog-service/internal/adapters/integration/trello/importer.go
+
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// externalSystem is the key used in BacklogItem.ExternalIDs for Trello
+const externalSystem = "trello"
+
+// boardExport is the subset of a Trello board export (JSON) the importer uses
+type boardExport struct {
+	Name  string `json:"name"`
+	Lists []struct {
+		ID     string `json:"id"`
+		Name   string `json:"name"`
+		Closed bool   `json:"closed"`
+	} `json:"lists"`
+	Cards []struct {
+		ID        string   `json:"id"`
+		Name      string   `json:"name"`
+		Desc      string   `json:"desc"`
+		IDList    string   `json:"idList"`
+		Closed    bool     `json:"closed"`
+		Pos       float64  `json:"pos"`
+		IDLabels  []string `json:"idLabels"`
+		IDChecklists []string `json:"idChecklists"`
+	} `json:"cards"`
+	Labels []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"labels"`
+	Checklists []struct {
+		ID         string `json:"id"`
+		IDCard     string `json:"idCard"`
+		Name       string `json:"name"`
+		CheckItems []struct {
+			Name  string `json:"name"`
+			State string `json:"state"`
+		} `json:"checkItems"`
+	} `json:"checklists"`
+}
+
+// Options configures a Trello import
+type Options struct {
+	// ListStatusMapping maps Trello list names to backlog statuses;
+	// unmapped lists fall back to NEW
+	ListStatusMapping map[string]model.ItemStatus
+
+	// SkipClosed skips archived lists and cards
+	SkipClosed bool
+
+	// DryRun produces the mapping report without creating any items
+	DryRun bool
+}
+
+// Report summarizes what an import did (or would do, for a dry run)
+type Report struct {
+	Board        string         `json:"board"`
+	DryRun       bool           `json:"dryRun"`
+	CardsMapped  int            `json:"cardsMapped"`
+	CardsSkipped int            `json:"cardsSkipped"`
+	ListStatuses map[string]model.ItemStatus `json:"listStatuses"`
+	Warnings     []string       `json:"warnings"`
+}
+
+// Importer converts a Trello board export into backlog stories: lists become
+// statuses, cards become stories, checklists become acceptance criteria in
+// the description, and labels become tags.
+type Importer struct {
+	backlog *service.BacklogService
+	logger  *zap.Logger
+}
+
+// NewImporter creates a new Trello importer
+func NewImporter(backlog *service.BacklogService, logger *zap.Logger) *Importer {
+	return &Importer{
+		backlog: backlog,
+		logger:  logger,
+	}
+}
+
+// Import parses a board export and creates the mapped stories. With
+// Options.DryRun it only returns the mapping report.
+func (i *Importer) Import(ctx context.Context, exportJSON []byte, opts Options) (*Report, error) {
+	var board boardExport
+	if err := json.Unmarshal(exportJSON, &board); err != nil {
+		return nil, fmt.Errorf("failed to parse Trello export: %w", err)
+	}
+
+	report := &Report{
+		Board:        board.Name,
+		DryRun:       opts.DryRun,
+		ListStatuses: make(map[string]model.ItemStatus),
+	}
+
+	// Resolve list → status mapping
+	listStatus := make(map[string]model.ItemStatus)
+	for _, list := range board.Lists {
+		if opts.SkipClosed && list.Closed {
+			continue
+		}
+		status, ok := opts.ListStatusMapping[list.Name]
+		if !ok {
+			status = model.ItemStatusNew
+			report.Warnings = append(report.Warnings,
+				fmt.Sprintf("list %q has no status mapping, using NEW", list.Name))
+		}
+		listStatus[list.ID] = status
+		report.ListStatuses[list.Name] = status
+	}
+
+	// Index labels and checklists for lookup
+	labelNames := make(map[string]string)
+	for _, label := range board.Labels {
+		labelNames[label.ID] = label.Name
+	}
+	cardChecklists := make(map[string][]string)
+	for _, checklist := range board.Checklists {
+		var lines []string
+		lines = append(lines, fmt.Sprintf("### %s", checklist.Name))
+		for _, item := range checklist.CheckItems {
+			mark := " "
+			if item.State == "complete" {
+				mark = "x"
+			}
+			lines = append(lines, fmt.Sprintf("- [%s] %s", mark, item.Name))
+		}
+		cardChecklists[checklist.IDCard] = append(cardChecklists[checklist.IDCard], strings.Join(lines, "\n"))
+	}
+
+	// Map cards to stories
+	for _, card := range board.Cards {
+		status, inScope := listStatus[card.IDList]
+		if !inScope || (opts.SkipClosed && card.Closed) {
+			report.CardsSkipped++
+			continue
+		}
+
+		// Checklists become an acceptance criteria section in the description
+		description := card.Desc
+		if checklists, ok := cardChecklists[card.ID]; ok {
+			description += "\n\n## Acceptance Criteria\n\n" + strings.Join(checklists, "\n\n")
+		}
+
+		var tags []string
+		for _, labelID := range card.IDLabels {
+			if name := labelNames[labelID]; name != "" {
+				tags = append(tags, name)
+			}
+		}
+
+		report.CardsMapped++
+		if opts.DryRun {
+			continue
+		}
+
+		item, err := i.backlog.CreateItem(ctx, &service.CreateItemRequest{
+			Type:        model.ItemTypeStory,
+			Title:       card.Name,
+			Description: description,
+			Tags:        tags,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to create story for card %q: %w", card.Name, err)
+		}
+
+		// Preserve the Trello card ID for traceability
+		if err := i.backlog.SetExternalID(ctx, item.ID, externalSystem, card.ID); err != nil {
+			i.logger.Error("Failed to store Trello external ID",
+				zap.String("cardId", card.ID),
+				zap.Error(err))
+		}
+
+		// Apply the mapped status when it differs from the default
+		if status != model.ItemStatusNew {
+			if _, err := i.backlog.UpdateItem(ctx, item.ID, &service.UpdateItemRequest{Status: &status}); err != nil {
+				i.logger.Error("Failed to set imported story status",
+					zap.String("cardId", card.ID),
+					zap.Error(err))
+			}
+		}
+	}
+
+	i.logger.Info("Trello import finished",
+		zap.String("board", board.Name),
+		zap.Bool("dryRun", opts.DryRun),
+		zap.Int("mapped", report.CardsMapped),
+		zap.Int("skipped", report.CardsSkipped))
+
+	return report, nil
+}
@@ -0,0 +1,245 @@
+// services/backlog-service/internal/domain/service/triage.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// TriageDecision records how an intake item was triaged
+type TriageDecision string
+
+const (
+	// TriageDecisionAccepted means the item was accepted into the backlog
+	TriageDecisionAccepted TriageDecision = "ACCEPTED"
+	// TriageDecisionMerged means the item was merged as a duplicate
+	TriageDecisionMerged TriageDecision = "MERGED"
+	// TriageDecisionRejected means the item was rejected with a reason
+	TriageDecisionRejected TriageDecision = "REJECTED"
+)
+
+// TriageRecord captures the outcome of triaging one intake item, used for
+// SLA tracking and triage metrics
+type TriageRecord struct {
+	ItemID      uuid.UUID      `json:"itemId"`
+	Decision    TriageDecision `json:"decision"`
+	TriagedBy   string         `json:"triagedBy"`
+	Reason      string         `json:"reason,omitempty"`
+	MergedInto  *uuid.UUID     `json:"mergedInto,omitempty"`
+	EnteredAt   time.Time      `json:"enteredAt"`
+	TriagedAt   time.Time      `json:"triagedAt"`
+}
+
+// TriageRepository persists triage records
+type TriageRepository interface {
+	// Record stores a triage record
+	Record(ctx context.Context, record *TriageRecord) error
+
+	// AverageTriageTime returns the average intake-to-decision duration over
+	// the time window
+	AverageTriageTime(ctx context.Context, timeWindowDays int) (time.Duration, error)
+
+	// CountByDecision returns triage counts per decision over the time window
+	CountByDecision(ctx context.Context, timeWindowDays int) (map[TriageDecision]int, error)
+}
+
+// AcceptRequest carries the triager's classification of an accepted item
+type AcceptRequest struct {
+	Type        model.ItemType
+	ParentID    *uuid.UUID
+	Priority    int
+	StoryPoints int
+}
+
+// TriageMetrics summarizes triage performance for the metrics API
+type TriageMetrics struct {
+	QueueDepth        int                    `json:"queueDepth"`
+	AverageTriageTime time.Duration          `json:"averageTriageTime"`
+	SLABreaches       int                    `json:"slaBreaches"`
+	Decisions         map[TriageDecision]int `json:"decisions"`
+}
+
+// TriageService implements the intake queue ahead of NEW: triagers accept,
+// merge as duplicate, or reject items that arrived through open channels
+type TriageService struct {
+	backlog *BacklogService
+	repo    repository.BacklogRepository
+	triage  TriageRepository
+	sla     time.Duration
+	logger  *zap.Logger
+}
+
+// NewTriageService creates a new triage service. sla is the target
+// intake-to-decision time used in metrics.
+func NewTriageService(backlog *BacklogService, repo repository.BacklogRepository, triage TriageRepository, sla time.Duration, logger *zap.Logger) *TriageService {
+	if sla <= 0 {
+		sla = 48 * time.Hour
+	}
+	return &TriageService{
+		backlog: backlog,
+		repo:    repo,
+		triage:  triage,
+		sla:     sla,
+		logger:  logger,
+	}
+}
+
+// ListQueue retrieves the intake queue, oldest first
+func (s *TriageService) ListQueue(ctx context.Context, limit, offset int) ([]*model.BacklogItem, int64, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	return s.repo.List(ctx, repository.BacklogFilter{
+		Statuses:  []model.ItemStatus{model.ItemStatusIntake},
+		Limit:     limit,
+		Offset:    offset,
+		SortBy:    "created_at",
+		SortOrder: "asc",
+	})
+}
+
+// Accept moves an intake item into the backlog with the triager's
+// classification applied
+func (s *TriageService) Accept(ctx context.Context, itemID uuid.UUID, triagedBy string, req *AcceptRequest) error {
+	item, err := s.requireIntake(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	status := model.ItemStatusNew
+	update := &UpdateItemRequest{
+		Status:   &status,
+		ParentID: req.ParentID,
+		Priority: &req.Priority,
+	}
+	if req.StoryPoints > 0 {
+		update.StoryPoints = &req.StoryPoints
+	}
+	if _, err := s.backlog.UpdateItem(ctx, itemID, update); err != nil {
+		return err
+	}
+
+	return s.record(ctx, item, TriageDecisionAccepted, triagedBy, "", nil)
+}
+
+// MergeDuplicate closes an intake item as a duplicate of an existing item
+func (s *TriageService) MergeDuplicate(ctx context.Context, itemID, duplicateOf uuid.UUID, triagedBy string) error {
+	item, err := s.requireIntake(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	// The canonical item must exist
+	if _, err := s.repo.GetByID(ctx, duplicateOf); err != nil {
+		return err
+	}
+
+	status := model.ItemStatusRejected
+	reason := fmt.Sprintf("duplicate of %s", duplicateOf)
+	if _, err := s.backlog.UpdateItem(ctx, itemID, &UpdateItemRequest{Status: &status}); err != nil {
+		return err
+	}
+
+	return s.record(ctx, item, TriageDecisionMerged, triagedBy, reason, &duplicateOf)
+}
+
+// Reject closes an intake item with a reason
+func (s *TriageService) Reject(ctx context.Context, itemID uuid.UUID, triagedBy, reason string) error {
+	if reason == "" {
+		return errors.New("rejection reason cannot be empty")
+	}
+
+	item, err := s.requireIntake(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	status := model.ItemStatusRejected
+	if _, err := s.backlog.UpdateItem(ctx, itemID, &UpdateItemRequest{Status: &status}); err != nil {
+		return err
+	}
+
+	return s.record(ctx, item, TriageDecisionRejected, triagedBy, reason, nil)
+}
+
+// GetTriageMetrics summarizes queue depth, triage time against the SLA, and
+// decision counts over the time window
+func (s *TriageService) GetTriageMetrics(ctx context.Context, timeWindowDays int) (*TriageMetrics, error) {
+	if timeWindowDays <= 0 {
+		timeWindowDays = 30
+	}
+
+	queue, _, err := s.repo.List(ctx, repository.BacklogFilter{
+		Statuses: []model.ItemStatus{model.ItemStatusIntake},
+		Limit:    1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	avgTime, err := s.triage.AverageTriageTime(ctx, timeWindowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.triage.CountByDecision(ctx, timeWindowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count queue entries already past the SLA
+	breaches := 0
+	now := time.Now().UTC()
+	for _, item := range queue {
+		if now.Sub(item.CreatedAt) > s.sla {
+			breaches++
+		}
+	}
+
+	return &TriageMetrics{
+		QueueDepth:        len(queue),
+		AverageTriageTime: avgTime,
+		SLABreaches:       breaches,
+		Decisions:         decisions,
+	}, nil
+}
+
+// requireIntake loads an item and verifies it is in the intake queue
+func (s *TriageService) requireIntake(ctx context.Context, itemID uuid.UUID) (*model.BacklogItem, error) {
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != model.ItemStatusIntake {
+		return nil, errors.New("item is not in the intake queue")
+	}
+	return item, nil
+}
+
+// record stores the triage decision
+func (s *TriageService) record(ctx context.Context, item *model.BacklogItem, decision TriageDecision, triagedBy, reason string, mergedInto *uuid.UUID) error {
+	record := &TriageRecord{
+		ItemID:     item.ID,
+		Decision:   decision,
+		TriagedBy:  triagedBy,
+		Reason:     reason,
+		MergedInto: mergedInto,
+		EnteredAt:  item.CreatedAt,
+		TriagedAt:  time.Now().UTC(),
+	}
+	if err := s.triage.Record(ctx, record); err != nil {
+		s.logger.Error("Failed to store triage record",
+			zap.String("itemId", item.ID.String()),
+			zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// services/backlog-service/internal/domain/model/type_registry.go
+
+package model
+
+import (
+	"errors"
+	"sync"
+)
+
+// ItemTypeDefinition describes one item type a workspace can use, including
+// the hierarchy rule and presentation metadata for its cards
+type ItemTypeDefinition struct {
+	Type            ItemType   `json:"type"`
+	Name            string     `json:"name"`
+	Icon            string     `json:"icon"`
+	Color           string     `json:"color"`
+	AllowedChildren []ItemType `json:"allowedChildren"`
+}
+
+// TypeRegistry holds a workspace's item types and hierarchy rules. The
+// built-in EPIC/FEATURE/STORY types are always present so existing APIs keep
+// working; workspaces extend the registry with their own types (Initiative,
+// Bug, Spike, Task, ...).
+type TypeRegistry struct {
+	mu    sync.RWMutex
+	types map[ItemType]*ItemTypeDefinition
+}
+
+// NewTypeRegistry creates a registry seeded with the built-in types and the
+// default epic→feature→story hierarchy
+func NewTypeRegistry() *TypeRegistry {
+	r := &TypeRegistry{types: make(map[ItemType]*ItemTypeDefinition)}
+	r.types[ItemTypeEpic] = &ItemTypeDefinition{
+		Type:            ItemTypeEpic,
+		Name:            "Epic",
+		Icon:            "epic",
+		Color:           "#904EE2",
+		AllowedChildren: []ItemType{ItemTypeFeature},
+	}
+	r.types[ItemTypeFeature] = &ItemTypeDefinition{
+		Type:            ItemTypeFeature,
+		Name:            "Feature",
+		Icon:            "feature",
+		Color:           "#4BADE8",
+		AllowedChildren: []ItemType{ItemTypeStory, ItemTypeSpike},
+	}
+	r.types[ItemTypeStory] = &ItemTypeDefinition{
+		Type:            ItemTypeStory,
+		Name:            "Story",
+		Icon:            "story",
+		Color:           "#63BA3C",
+		AllowedChildren: []ItemType{ItemTypeTask},
+	}
+	r.types[ItemTypeTask] = &ItemTypeDefinition{
+		Type:            ItemTypeTask,
+		Name:            "Task",
+		Icon:            "task",
+		Color:           "#6B778C",
+		AllowedChildren: nil,
+	}
+	r.types[ItemTypeSpike] = &ItemTypeDefinition{
+		Type:            ItemTypeSpike,
+		Name:            "Spike",
+		Icon:            "spike",
+		Color:           "#F2A33C",
+		AllowedChildren: nil,
+	}
+	return r
+}
+
+// Register adds or replaces a type definition. The built-in types can be
+// re-skinned (icon, color, children) but not removed.
+func (r *TypeRegistry) Register(def *ItemTypeDefinition) error {
+	if def == nil || def.Type == "" {
+		return errors.New("type definition requires a type")
+	}
+	if def.Name == "" {
+		return errors.New("type definition requires a name")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[def.Type] = def
+	return nil
+}
+
+// Remove deletes a custom type definition. The built-in types cannot be
+// removed, and callers must migrate items off the type first.
+func (r *TypeRegistry) Remove(itemType ItemType) error {
+	switch itemType {
+	case ItemTypeEpic, ItemTypeFeature, ItemTypeStory, ItemTypeTask, ItemTypeSpike:
+		return errors.New("built-in types cannot be removed")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.types[itemType]; !ok {
+		return errors.New("unknown type")
+	}
+	delete(r.types, itemType)
+	return nil
+}
+
+// Definition retrieves a type's definition
+func (r *TypeRegistry) Definition(itemType ItemType) (*ItemTypeDefinition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.types[itemType]
+	return def, ok
+}
+
+// IsValid reports whether a type is registered
+func (r *TypeRegistry) IsValid(itemType ItemType) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.types[itemType]
+	return ok
+}
+
+// IsValidParentChild reports whether the hierarchy rules allow childType
+// under parentType
+func (r *TypeRegistry) IsValidParentChild(parentType, childType ItemType) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	parent, ok := r.types[parentType]
+	if !ok {
+		return false
+	}
+	for _, allowed := range parent.AllowedChildren {
+		if allowed == childType {
+			return true
+		}
+	}
+	return false
+}
+
+// HasParentType reports whether any registered type allows childType as a
+// child, i.e. whether childType can appear below the top of the hierarchy
+func (r *TypeRegistry) HasParentType(childType ItemType) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, def := range r.types {
+		for _, allowed := range def.AllowedChildren {
+			if allowed == childType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// activeRegistry is the registry item validation runs against; it defaults
+// to the built-in types
+var activeRegistry = NewTypeRegistry()
+
+// SetTypeRegistry installs a workspace's type registry
+func SetTypeRegistry(registry *TypeRegistry) {
+	if registry != nil {
+		activeRegistry = registry
+	}
+}
+
+// ActiveTypeRegistry returns the registry item validation runs against
+func ActiveTypeRegistry() *TypeRegistry {
+	return activeRegistry
+}
+
+// Types lists all registered type definitions
+func (r *TypeRegistry) Types() []*ItemTypeDefinition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	defs := make([]*ItemTypeDefinition, 0, len(r.types))
+	for _, def := range r.types {
+		defs = append(defs, def)
+	}
+	return defs
+}
@@ -0,0 +1,343 @@
+// services/backlog-service/internal/domain/service/usage.go
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// Usage metrics tracked per workspace and day
+const (
+	// UsageMetricAPICalls counts API calls
+	UsageMetricAPICalls = "api_calls"
+	// UsageMetricItemsCreated counts items created
+	UsageMetricItemsCreated = "items_created"
+	// UsageMetricEventsPublished counts events published to the bus
+	UsageMetricEventsPublished = "events_published"
+)
+
+// UsageAnalyticsService tracks per-workspace usage into daily rollups and
+// serves usage reports over them. Tracking failures are logged, never
+// surfaced: usage accounting must not fail the request it measures.
+type UsageAnalyticsService struct {
+	usage  repository.UsageRepository
+	logger *zap.Logger
+}
+
+// NewUsageAnalyticsService creates a new usage analytics service
+func NewUsageAnalyticsService(usage repository.UsageRepository, logger *zap.Logger) *UsageAnalyticsService {
+	return &UsageAnalyticsService{
+		usage:  usage,
+		logger: logger,
+	}
+}
+
+// Track adds one to a workspace's metric for today
+func (s *UsageAnalyticsService) Track(ctx context.Context, workspaceID uuid.UUID, metric string) {
+	if err := s.usage.IncrementUsage(ctx, workspaceID, time.Now().UTC(), metric, 1); err != nil {
+		s.logger.Error("Failed to track usage",
+			zap.String("workspaceID", workspaceID.String()),
+			zap.String("metric", metric),
+			zap.Error(err))
+	}
+}
+
+// TrackActiveUser records an actor as active in a workspace today
+func (s *UsageAnalyticsService) TrackActiveUser(ctx context.Context, workspaceID uuid.UUID, actor string) {
+	if actor == "" {
+		return
+	}
+	if err := s.usage.RecordActiveUser(ctx, workspaceID, time.Now().UTC(), actor); err != nil {
+		s.logger.Error("Failed to track active user",
+			zap.String("workspaceID", workspaceID.String()),
+			zap.Error(err))
+	}
+}
+
+// GetUsageReport retrieves a workspace's usage totals within [from, to)
+func (s *UsageAnalyticsService) GetUsageReport(ctx context.Context, workspaceID uuid.UUID, from, to time.Time) (*repository.UsageReport, error) {
+	return s.usage.GetUsageReport(ctx, workspaceID, from, to)
+}
+
+// ExportUsageCSV renders a workspace's usage report as CSV, one metric per
+// line, with active users as the final line
+func (s *UsageAnalyticsService) ExportUsageCSV(ctx context.Context, workspaceID uuid.UUID, from, to time.Time) (string, error) {
+	report, err := s.usage.GetUsageReport(ctx, workspaceID, from, to)
+	if err != nil {
+		return "", err
+	}
+
+	metrics := make([]string, 0, len(report.Metrics))
+	for metric := range report.Metrics {
+		metrics = append(metrics, metric)
+	}
+	sort.Strings(metrics)
+
+	var b strings.Builder
+	b.WriteString("workspace_id,from,to,metric,value\n")
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "%s,%s,%s,%s,%d\n",
+			workspaceID, from.Format("2006-01-02"), to.Format("2006-01-02"), metric, report.Metrics[metric])
+	}
+	fmt.Fprintf(&b, "%s,%s,%s,active_users,%d\n",
+		workspaceID, from.Format("2006-01-02"), to.Format("2006-01-02"), report.ActiveUsers)
+
+	return b.String(), nil
+}
+
+// UsageTrackingPublisher wraps a Publisher and counts published events
+// against the workspace named in the payload, so event volume shows up in
+// usage reports alongside API calls
+type UsageTrackingPublisher struct {
+	inner event.Publisher
+	usage *UsageAnalyticsService
+}
+
+// NewUsageTrackingPublisher creates a new usage tracking publisher
+func NewUsageTrackingPublisher(inner event.Publisher, usage *UsageAnalyticsService) *UsageTrackingPublisher {
+	return &UsageTrackingPublisher{
+		inner: inner,
+		usage: usage,
+	}
+}
+
+// Publish delegates to the wrapped publisher and tracks event volume for
+// events that carry a workspace ID
+func (p *UsageTrackingPublisher) Publish(ctx context.Context, topic string, e interface{}) error {
+	if err := p.inner.Publish(ctx, topic, e); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil
+	}
+	var keys struct {
+		WorkspaceID *uuid.UUID `json:"workspaceId"`
+	}
+	if json.Unmarshal(payload, &keys) == nil && keys.WorkspaceID != nil {
+		p.usage.Track(ctx, *keys.WorkspaceID, UsageMetricEventsPublished)
+	}
+	return nil
+}
+
+// MonthlyUsageJob emits one usage event per workspace when a month closes,
+// the feed hosted billing and capacity planning consume. Run it under a
+// worker lease so only one replica reports each month.
+type MonthlyUsageJob struct {
+	usage          repository.UsageRepository
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewMonthlyUsageJob creates a new monthly usage job
+func NewMonthlyUsageJob(usage repository.UsageRepository, eventPublisher event.Publisher, logger *zap.Logger) *MonthlyUsageJob {
+	return &MonthlyUsageJob{
+		usage:          usage,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// Run emits usage events for the most recently closed calendar month and
+// returns how many workspaces were reported
+func (j *MonthlyUsageJob) Run(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	periodEnd := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	periodStart := periodEnd.AddDate(0, -1, 0)
+
+	workspaces, err := j.usage.ListUsageWorkspaces(ctx, periodStart, periodEnd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list workspaces with usage: %w", err)
+	}
+
+	reported := 0
+	for _, workspaceID := range workspaces {
+		report, err := j.usage.GetUsageReport(ctx, workspaceID, periodStart, periodEnd)
+		if err != nil {
+			j.logger.Error("Failed to build monthly usage report",
+				zap.String("workspaceID", workspaceID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		usageEvent := event.NewMonthlyUsageEvent(workspaceID, periodStart, periodEnd, report.Metrics, report.ActiveUsers)
+		if err := j.eventPublisher.Publish(ctx, "backlog.usage.monthly", usageEvent); err != nil {
+			j.logger.Error("Failed to publish monthly usage event",
+				zap.String("workspaceID", workspaceID.String()),
+				zap.Error(err))
+			continue
+		}
+		reported++
+	}
+
+	j.logger.Info("Monthly usage reported",
+		zap.String("period", periodStart.Format("2006-01")),
+		zap.Int("workspaces", reported))
+	return reported, nil
+}
+
+// Start runs the job daily, emitting reports on the first day of each month
+func (j *MonthlyUsageJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Now().UTC().Day() != 1 {
+				continue
+			}
+			if _, err := j.Run(ctx); err != nil {
+				j.logger.Error("Monthly usage job failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// services/backlog-service/internal/adapters/grpc/usage_interceptor.go
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ubmm/backlog-service/internal/domain/service"
+)
+
+// UsageInterceptor tracks API usage per workspace from request metadata:
+// every call counts against api_calls, successful item creations against
+// items_created, and the calling user is recorded as active. Requests
+// without an x-workspace-id header are untracked.
+func UsageInterceptor(usage *service.UsageAnalyticsService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		workspaceID, actor := usageIdentity(ctx)
+		if workspaceID != nil {
+			usage.Track(ctx, *workspaceID, service.UsageMetricAPICalls)
+			usage.TrackActiveUser(ctx, *workspaceID, actor)
+			if err == nil && strings.HasSuffix(info.FullMethod, "/CreateItem") {
+				usage.Track(ctx, *workspaceID, service.UsageMetricItemsCreated)
+			}
+		}
+
+		return resp, err
+	}
+}
+
+// usageIdentity reads the workspace and user identity from request metadata
+func usageIdentity(ctx context.Context) (*uuid.UUID, string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ""
+	}
+
+	values := md.Get("x-workspace-id")
+	if len(values) == 0 {
+		return nil, ""
+	}
+	workspaceID, err := uuid.Parse(values[0])
+	if err != nil {
+		return nil, ""
+	}
+
+	actor := ""
+	if users := md.Get("x-user-id"); len(users) > 0 {
+		actor = users[0]
+	}
+	return &workspaceID, actor
+}
+
+// services/backlog-service/internal/adapters/grpc/usage_server.go
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ubmm/backlog-service/internal/domain/service"
+	pb "github.com/ubmm/backlog-service/pkg/api/v1"
+)
+
+// UsageServer implements the gRPC usage service
+type UsageServer struct {
+	pb.UnimplementedUsageServiceServer
+	usage  *service.UsageAnalyticsService
+	logger *zap.Logger
+}
+
+// NewUsageServer creates a new usage gRPC server
+func NewUsageServer(usage *service.UsageAnalyticsService, logger *zap.Logger) *UsageServer {
+	return &UsageServer{
+		usage:  usage,
+		logger: logger,
+	}
+}
+
+// GetUsageReport retrieves a workspace's usage totals for a period
+func (s *UsageServer) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.UsageReport, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace ID: %v", err)
+	}
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	report, err := s.usage.GetUsageReport(ctx, workspaceID, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		s.logger.Error("Failed to get usage report", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get usage report: %v", err)
+	}
+
+	return &pb.UsageReport{
+		WorkspaceId: report.WorkspaceID.String(),
+		From:        req.From,
+		To:          req.To,
+		Metrics:     report.Metrics,
+		ActiveUsers: int32(report.ActiveUsers),
+	}, nil
+}
+
+// ExportUsage renders a workspace's usage report as CSV
+func (s *UsageServer) ExportUsage(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.ExportUsageResponse, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace ID: %v", err)
+	}
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	csv, err := s.usage.ExportUsageCSV(ctx, workspaceID, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		s.logger.Error("Failed to export usage", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to export usage: %v", err)
+	}
+
+	return &pb.ExportUsageResponse{Csv: csv}, nil
+}
@@ -0,0 +1,25 @@
+-- services/backlog-service/migrations/000012_usage_rollups.up.sql
+
+-- Per-workspace usage rollups, one row per workspace, day and metric.
+-- Increments are idempotent upserts so trackers never need a read first.
+CREATE TABLE IF NOT EXISTS usage_rollups (
+    workspace_id UUID NOT NULL,
+    day DATE NOT NULL,
+    metric TEXT NOT NULL,
+    value BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (workspace_id, day, metric)
+);
+
+-- Distinct actors per workspace and day; active user counts are
+-- COUNT(DISTINCT actor) over a period
+CREATE TABLE IF NOT EXISTS usage_actors (
+    workspace_id UUID NOT NULL,
+    day DATE NOT NULL,
+    actor TEXT NOT NULL,
+    PRIMARY KEY (workspace_id, day, actor)
+);
+
+-- services/backlog-service/migrations/000012_usage_rollups.down.sql
+
+DROP TABLE IF EXISTS usage_actors;
+DROP TABLE IF EXISTS usage_rollups;
@@ -0,0 +1,134 @@
+// services/backlog-service/internal/domain/service/votes.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// Vote is one user's endorsement of a backlog item. Weight defaults to 1 and
+// can be raised for key accounts.
+type Vote struct {
+	ItemID    uuid.UUID `json:"itemId"`
+	UserID    string    `json:"userId"`
+	Weight    int       `json:"weight"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// VoteSummary is the vote block attached to item payloads
+type VoteSummary struct {
+	VoteCount      int `json:"voteCount"`
+	WeightedVotes  int `json:"weightedVotes"`
+}
+
+// VoteRepository defines the interface for vote persistence
+type VoteRepository interface {
+	// Create stores a vote; implementations enforce one vote per user per item
+	Create(ctx context.Context, vote *Vote) error
+
+	// Delete removes a user's vote from an item
+	Delete(ctx context.Context, itemID uuid.UUID, userID string) error
+
+	// Get retrieves a user's vote on an item, or nil if none
+	Get(ctx context.Context, itemID uuid.UUID, userID string) (*Vote, error)
+
+	// Summary retrieves the vote counts for an item
+	Summary(ctx context.Context, itemID uuid.UUID) (*VoteSummary, error)
+}
+
+// VoteService manages votes so prioritization can incorporate demand signals
+type VoteService struct {
+	votes          VoteRepository
+	repo           repository.BacklogRepository
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewVoteService creates a new vote service
+func NewVoteService(votes VoteRepository, repo repository.BacklogRepository, eventPublisher event.Publisher, logger *zap.Logger) *VoteService {
+	return &VoteService{
+		votes:          votes,
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// CastVote records a vote for an item. weight below 1 defaults to 1; a user
+// voting twice on the same item is an error.
+func (s *VoteService) CastVote(ctx context.Context, itemID uuid.UUID, userID string, weight int) error {
+	if userID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+	if weight < 1 {
+		weight = 1
+	}
+
+	// Make sure the item exists
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return err
+	}
+
+	// Enforce one vote per user per item
+	existing, err := s.votes.Get(ctx, itemID, userID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.New("user has already voted on this item")
+	}
+
+	vote := &Vote{
+		ItemID:    itemID,
+		UserID:    userID,
+		Weight:    weight,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.votes.Create(ctx, vote); err != nil {
+		return err
+	}
+
+	// Publish event
+	voteEvent := event.NewItemVoteCastEvent(itemID, userID, weight)
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.vote.cast", voteEvent); err != nil {
+		s.logger.Error("Failed to publish vote cast event", zap.Error(err))
+	}
+
+	return nil
+}
+
+// RemoveVote removes a user's vote from an item
+func (s *VoteService) RemoveVote(ctx context.Context, itemID uuid.UUID, userID string) error {
+	existing, err := s.votes.Get(ctx, itemID, userID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errors.New("user has not voted on this item")
+	}
+
+	if err := s.votes.Delete(ctx, itemID, userID); err != nil {
+		return err
+	}
+
+	// Publish event
+	voteEvent := event.NewItemVoteRemovedEvent(itemID, userID)
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.vote.removed", voteEvent); err != nil {
+		s.logger.Error("Failed to publish vote removed event", zap.Error(err))
+	}
+
+	return nil
+}
+
+// GetVoteSummary retrieves the vote counts for inclusion in the item payload
+func (s *VoteService) GetVoteSummary(ctx context.Context, itemID uuid.UUID) (*VoteSummary, error) {
+	return s.votes.Summary(ctx, itemID)
+}
@@ -0,0 +1,187 @@
+// services/backlog-service/internal/adapters/httpapi/webhook_ingest.go
+
+package httpapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WebhookVerifier validates the authenticity of an inbound webhook request
+// for one connector
+type WebhookVerifier interface {
+	// Verify checks the request signature against the raw body
+	Verify(r *http.Request, body []byte) error
+}
+
+// HMACVerifier verifies hex-encoded HMAC-SHA256 signatures carried in a header
+type HMACVerifier struct {
+	Secret string
+	Header string // e.g. "X-Hub-Signature-256"
+	Prefix string // e.g. "sha256="
+}
+
+// Verify checks the HMAC signature
+func (v *HMACVerifier) Verify(r *http.Request, body []byte) error {
+	signature := strings.TrimPrefix(r.Header.Get(v.Header), v.Prefix)
+	if signature == "" {
+		return fmt.Errorf("missing webhook signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(v.Secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("invalid webhook signature")
+	}
+	return nil
+}
+
+// InboundWebhook is a verified webhook handed to the async processor
+type InboundWebhook struct {
+	Connector  string
+	DeliveryID string
+	Headers    map[string]string
+	Body       []byte
+	ReceivedAt time.Time
+}
+
+// WebhookQueue hands verified webhooks to the async processing workers
+type WebhookQueue interface {
+	// Enqueue adds a webhook to the processing queue
+	Enqueue(ctx context.Context, webhook *InboundWebhook) error
+}
+
+// ReplayGuard rejects webhook deliveries that were already seen
+type ReplayGuard interface {
+	// Seen records a delivery ID and reports whether it was already processed
+	Seen(ctx context.Context, connector, deliveryID string) (bool, error)
+}
+
+// WebhookIngestHandler serves POST /webhooks/{connector}: the single front
+// door for Jira/GitHub/GitLab/Slack inbound events. It verifies the request,
+// enforces size limits and replay protection, and enqueues the payload for
+// async processing.
+type WebhookIngestHandler struct {
+	verifiers    map[string]WebhookVerifier
+	queue        WebhookQueue
+	replayGuard  ReplayGuard
+	maxBodyBytes int64
+	logger       *zap.Logger
+}
+
+// NewWebhookIngestHandler creates a new webhook ingest handler
+func NewWebhookIngestHandler(verifiers map[string]WebhookVerifier, queue WebhookQueue, replayGuard ReplayGuard, maxBodyBytes int64, logger *zap.Logger) *WebhookIngestHandler {
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = 1024 * 1024 // 1 MiB default
+	}
+	return &WebhookIngestHandler{
+		verifiers:    verifiers,
+		queue:        queue,
+		replayGuard:  replayGuard,
+		maxBodyBytes: maxBodyBytes,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP handles an inbound webhook request
+func (h *WebhookIngestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Route on the trailing path segment: /webhooks/{connector}
+	connector := strings.TrimPrefix(r.URL.Path, "/webhooks/")
+	verifier, ok := h.verifiers[connector]
+	if !ok || connector == "" {
+		http.Error(w, "unknown connector", http.StatusNotFound)
+		return
+	}
+
+	// Enforce the payload size limit while reading
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBodyBytes+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBodyBytes {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Verify authenticity before doing anything else
+	if err := verifier.Verify(r, body); err != nil {
+		h.logger.Warn("Rejected webhook with bad signature",
+			zap.String("connector", connector),
+			zap.Error(err))
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	// Replay protection keyed on the provider's delivery ID
+	deliveryID := deliveryIDFrom(r)
+	if deliveryID != "" {
+		seen, err := h.replayGuard.Seen(r.Context(), connector, deliveryID)
+		if err != nil {
+			h.logger.Error("Replay guard check failed",
+				zap.String("connector", connector),
+				zap.Error(err))
+		} else if seen {
+			// Acknowledge duplicates without reprocessing
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+	}
+
+	// Hand off to the async processor; never process inline
+	webhook := &InboundWebhook{
+		Connector:  connector,
+		DeliveryID: deliveryID,
+		Headers:    flattenHeaders(r),
+		Body:       body,
+		ReceivedAt: time.Now().UTC(),
+	}
+	if err := h.queue.Enqueue(r.Context(), webhook); err != nil {
+		h.logger.Error("Failed to enqueue webhook",
+			zap.String("connector", connector),
+			zap.Error(err))
+		http.Error(w, "failed to accept webhook", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// deliveryIDFrom extracts the provider's delivery/event ID header
+func deliveryIDFrom(r *http.Request) string {
+	for _, header := range []string{
+		"X-GitHub-Delivery",
+		"X-Gitlab-Event-UUID",
+		"X-Atlassian-Webhook-Identifier",
+		"X-Slack-Request-Timestamp",
+	} {
+		if value := r.Header.Get(header); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// flattenHeaders copies the request headers for the async processor
+func flattenHeaders(r *http.Request) map[string]string {
+	headers := make(map[string]string, len(r.Header))
+	for key := range r.Header {
+		headers[key] = r.Header.Get(key)
+	}
+	return headers
+}
@@ -0,0 +1,154 @@
+// services/backlog-service/internal/domain/service/weblinks.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// WebLinkType classifies what an attached link points at
+type WebLinkType string
+
+const (
+	// WebLinkTypeDesignDoc represents a design document link
+	WebLinkTypeDesignDoc WebLinkType = "DESIGN_DOC"
+	// WebLinkTypeSpec represents a specification link
+	WebLinkTypeSpec WebLinkType = "SPEC"
+	// WebLinkTypeIncident represents an incident link
+	WebLinkTypeIncident WebLinkType = "INCIDENT"
+	// WebLinkTypeOther represents any other link
+	WebLinkTypeOther WebLinkType = "OTHER"
+)
+
+// WebLink attaches external context (docs, specs, incidents) to an item so it
+// lives on the item rather than buried in descriptions
+type WebLink struct {
+	ID        uuid.UUID   `json:"id"`
+	ItemID    uuid.UUID   `json:"itemId"`
+	URL       string      `json:"url"`
+	Title     string      `json:"title"`
+	Type      WebLinkType `json:"type"`
+	CreatedAt time.Time   `json:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
+// WebLinkRepository defines the interface for web link persistence
+type WebLinkRepository interface {
+	// Create stores a new web link
+	Create(ctx context.Context, link *WebLink) error
+
+	// GetByID retrieves a web link by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*WebLink, error)
+
+	// GetByItemID retrieves all web links for an item
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*WebLink, error)
+
+	// Update updates an existing web link
+	Update(ctx context.Context, link *WebLink) error
+
+	// Delete removes a web link
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WebLinkService manages web links on backlog items
+type WebLinkService struct {
+	links WebLinkRepository
+	repo  repository.BacklogRepository
+}
+
+// NewWebLinkService creates a new web link service
+func NewWebLinkService(links WebLinkRepository, repo repository.BacklogRepository) *WebLinkService {
+	return &WebLinkService{
+		links: links,
+		repo:  repo,
+	}
+}
+
+// AddLink attaches a validated web link to an item
+func (s *WebLinkService) AddLink(ctx context.Context, itemID uuid.UUID, rawURL, title string, linkType WebLinkType) (*WebLink, error) {
+	if err := validateLink(rawURL, title, linkType); err != nil {
+		return nil, err
+	}
+
+	// Make sure the item exists
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	link := &WebLink{
+		ID:        uuid.New(),
+		ItemID:    itemID,
+		URL:       rawURL,
+		Title:     title,
+		Type:      linkType,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.links.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// UpdateLink updates a web link's URL, title, or type
+func (s *WebLinkService) UpdateLink(ctx context.Context, id uuid.UUID, rawURL, title string, linkType WebLinkType) (*WebLink, error) {
+	if err := validateLink(rawURL, title, linkType); err != nil {
+		return nil, err
+	}
+
+	link, err := s.links.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	link.URL = rawURL
+	link.Title = title
+	link.Type = linkType
+	link.UpdatedAt = time.Now().UTC()
+	if err := s.links.Update(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// RemoveLink deletes a web link
+func (s *WebLinkService) RemoveLink(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.links.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.links.Delete(ctx, id)
+}
+
+// GetLinks retrieves all web links for an item
+func (s *WebLinkService) GetLinks(ctx context.Context, itemID uuid.UUID) ([]*WebLink, error) {
+	return s.links.GetByItemID(ctx, itemID)
+}
+
+// validateLink checks the URL, title, and link type
+func validateLink(rawURL, title string, linkType WebLinkType) error {
+	if title == "" {
+		return errors.New("link title cannot be empty")
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("link URL must be a valid http(s) URL")
+	}
+
+	switch linkType {
+	case WebLinkTypeDesignDoc, WebLinkTypeSpec, WebLinkTypeIncident, WebLinkTypeOther:
+		return nil
+	default:
+		return errors.New("invalid link type")
+	}
+}
@@ -0,0 +1,128 @@
+// services/backlog-service/internal/domain/service/coordination.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// leaseRetryInterval is how often a replica retries a lease another replica
+// holds
+const leaseRetryInterval = 15 * time.Second
+
+// WorkerCoordinator hands out named leases so background subsystems (jobs,
+// outbox relay, sync workers) run on exactly one replica at a time, and
+// assigns partitioned work by hash for subsystems that scale out instead.
+type WorkerCoordinator struct {
+	leases repository.LeaseRepository
+	owner  string
+	logger *zap.Logger
+}
+
+// NewWorkerCoordinator creates a new worker coordinator. The owner identity
+// combines the hostname with a random suffix so restarts on the same host
+// are distinguishable.
+func NewWorkerCoordinator(leases repository.LeaseRepository, logger *zap.Logger) *WorkerCoordinator {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &WorkerCoordinator{
+		leases: leases,
+		owner:  fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8]),
+		logger: logger,
+	}
+}
+
+// Owner returns this replica's lease owner identity
+func (c *WorkerCoordinator) Owner() string {
+	return c.owner
+}
+
+// RunWithLease runs fn under the named lease: it waits until the lease is
+// acquired, renews it in the background, and cancels fn's context if a
+// renewal fails so another replica can take over without double-running.
+// The lease is released when fn returns.
+func (c *WorkerCoordinator) RunWithLease(ctx context.Context, name string, ttl time.Duration, fn func(ctx context.Context) error) error {
+	for {
+		acquired, err := c.leases.AcquireLease(ctx, name, c.owner, time.Now().Add(ttl))
+		if err != nil {
+			c.logger.Error("Failed to acquire lease", zap.String("lease", name), zap.Error(err))
+		} else if acquired {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(leaseRetryInterval):
+		}
+	}
+	c.logger.Info("Acquired lease", zap.String("lease", name), zap.String("owner", c.owner))
+
+	leaseCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Renew at a third of the TTL so transient database hiccups don't cost
+	// the lease
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-leaseCtx.Done():
+				return
+			case <-ticker.C:
+				renewed, err := c.leases.RenewLease(leaseCtx, name, c.owner, time.Now().Add(ttl))
+				if err != nil {
+					c.logger.Error("Failed to renew lease", zap.String("lease", name), zap.Error(err))
+					continue
+				}
+				if !renewed {
+					c.logger.Warn("Lost lease to another replica, stopping work",
+						zap.String("lease", name))
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	defer func() {
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer releaseCancel()
+		if err := c.leases.ReleaseLease(releaseCtx, name, c.owner); err != nil {
+			c.logger.Error("Failed to release lease", zap.String("lease", name), zap.Error(err))
+		}
+	}()
+
+	return fn(leaseCtx)
+}
+
+// PartitionFor maps an item ID onto one of n partitions by hash, so
+// subsystems that scale out can split work deterministically across
+// replicas holding different partition leases
+func PartitionFor(id uuid.UUID, partitions int) int {
+	if partitions <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write(id[:])
+	return int(h.Sum32() % uint32(partitions))
+}
+
+// ListWorkerLeases retrieves current lease ownership for operational
+// visibility
+func (c *WorkerCoordinator) ListWorkerLeases(ctx context.Context) ([]*repository.WorkerLease, error) {
+	return c.leases.ListLeases(ctx)
+}
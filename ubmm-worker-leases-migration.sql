@@ -0,0 +1,15 @@
+-- services/backlog-service/migrations/000011_worker_leases.up.sql
+
+-- Worker leases keep background subsystems (jobs, outbox relay, sync
+-- workers) from double-running across replicas: one row per named piece of
+-- work, owned by one replica until the lease expires
+CREATE TABLE IF NOT EXISTS worker_leases (
+    name TEXT PRIMARY KEY,
+    owner TEXT NOT NULL,
+    acquired_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- services/backlog-service/migrations/000011_worker_leases.down.sql
+
+DROP TABLE IF EXISTS worker_leases;
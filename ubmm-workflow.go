@@ -0,0 +1,153 @@
+// services/backlog-service/internal/domain/model/workflow.go
+
+package model
+
+import (
+	"errors"
+	"sync"
+)
+
+// StatusCategory is the canonical bucket a status maps to; metrics and
+// boards reason about categories so custom statuses don't break them
+type StatusCategory string
+
+const (
+	// StatusCategoryTodo covers statuses before work starts
+	StatusCategoryTodo StatusCategory = "TODO"
+	// StatusCategoryInProgress covers statuses with active work
+	StatusCategoryInProgress StatusCategory = "IN_PROGRESS"
+	// StatusCategoryDone covers terminal statuses
+	StatusCategoryDone StatusCategory = "DONE"
+)
+
+// StatusDefinition describes one status a workspace can use
+type StatusDefinition struct {
+	Status   ItemStatus     `json:"status"`
+	Name     string         `json:"name"`
+	Category StatusCategory `json:"category"`
+}
+
+// Workflow holds a workspace's statuses. The built-in statuses are always
+// present so existing APIs keep working; workspaces add their own (e.g.
+// IN_REVIEW) mapped to a canonical category.
+type Workflow struct {
+	mu       sync.RWMutex
+	statuses map[ItemStatus]*StatusDefinition
+}
+
+// NewWorkflow creates a workflow seeded with the built-in statuses
+func NewWorkflow() *Workflow {
+	w := &Workflow{statuses: make(map[ItemStatus]*StatusDefinition)}
+	for _, def := range []*StatusDefinition{
+		{Status: ItemStatusIntake, Name: "Intake", Category: StatusCategoryTodo},
+		{Status: ItemStatusNew, Name: "New", Category: StatusCategoryTodo},
+		{Status: ItemStatusReady, Name: "Ready", Category: StatusCategoryTodo},
+		{Status: ItemStatusInProgress, Name: "In Progress", Category: StatusCategoryInProgress},
+		{Status: ItemStatusBlocked, Name: "Blocked", Category: StatusCategoryInProgress},
+		{Status: ItemStatusDone, Name: "Done", Category: StatusCategoryDone},
+		{Status: ItemStatusRejected, Name: "Rejected", Category: StatusCategoryDone},
+	} {
+		w.statuses[def.Status] = def
+	}
+	return w
+}
+
+// Register adds or replaces a status definition
+func (w *Workflow) Register(def *StatusDefinition) error {
+	if def == nil || def.Status == "" {
+		return errors.New("status definition requires a status")
+	}
+	switch def.Category {
+	case StatusCategoryTodo, StatusCategoryInProgress, StatusCategoryDone:
+	default:
+		return errors.New("invalid status category")
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.statuses[def.Status] = def
+	return nil
+}
+
+// Remove deletes a custom status definition. The built-in statuses cannot
+// be removed, and callers must migrate items off the status first.
+func (w *Workflow) Remove(status ItemStatus) error {
+	if isBuiltinStatus(status) {
+		return errors.New("built-in statuses cannot be removed")
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.statuses[status]; !ok {
+		return errors.New("unknown status")
+	}
+	delete(w.statuses, status)
+	return nil
+}
+
+// IsValid reports whether a status is registered
+func (w *Workflow) IsValid(status ItemStatus) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	_, ok := w.statuses[status]
+	return ok
+}
+
+// Category retrieves a status's canonical category, defaulting to TODO for
+// unknown statuses
+func (w *Workflow) Category(status ItemStatus) StatusCategory {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if def, ok := w.statuses[status]; ok {
+		return def.Category
+	}
+	return StatusCategoryTodo
+}
+
+// StatusesInCategory lists the statuses mapped to a category, so metrics
+// and boards can honor custom workflows
+func (w *Workflow) StatusesInCategory(category StatusCategory) []ItemStatus {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var statuses []ItemStatus
+	for status, def := range w.statuses {
+		if def.Category == category {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// Statuses lists all registered status definitions
+func (w *Workflow) Statuses() []*StatusDefinition {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	defs := make([]*StatusDefinition, 0, len(w.statuses))
+	for _, def := range w.statuses {
+		defs = append(defs, def)
+	}
+	return defs
+}
+
+// activeWorkflow is the workflow status validation runs against
+var activeWorkflow = NewWorkflow()
+
+// SetWorkflow installs a workspace's workflow
+func SetWorkflow(workflow *Workflow) {
+	if workflow != nil {
+		activeWorkflow = workflow
+	}
+}
+
+// ActiveWorkflow returns the workflow status validation runs against
+func ActiveWorkflow() *Workflow {
+	return activeWorkflow
+}
+
+// isBuiltinStatus reports whether a status is one of the original enum values
+func isBuiltinStatus(status ItemStatus) bool {
+	switch status {
+	case ItemStatusIntake, ItemStatusNew, ItemStatusReady,
+		ItemStatusInProgress, ItemStatusDone, ItemStatusBlocked, ItemStatusRejected:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// services/backlog-service/internal/domain/service/workload.go
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ubmm/backlog-service/internal/domain/model"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// AssigneeWorkload is one assignee's slice of the open backlog
+type AssigneeWorkload struct {
+	Assignee       string `json:"assignee"`
+	OpenItems      int    `json:"openItems"`
+	InProgress     int    `json:"inProgress"`
+	PointsInFlight int    `json:"pointsInFlight"`
+	Capacity       int    `json:"capacity"` // configured points capacity, 0 = unknown
+	Overallocated  bool   `json:"overallocated"`
+}
+
+// WorkloadFilter restricts the workload report. Teams and sprints are
+// modelled as tags, matching how the rest of the service slices the backlog.
+type WorkloadFilter struct {
+	Tags []string
+}
+
+// WorkloadReport is the per-assignee workload breakdown leads use to
+// rebalance before the standup
+type WorkloadReport struct {
+	Assignees  []*AssigneeWorkload `json:"assignees"`
+	Unassigned int                 `json:"unassigned"`
+}
+
+// GetAssigneeWorkload builds the workload report. capacities maps assignee
+// to their points capacity; assignees whose in-flight points exceed it are
+// flagged as overallocated.
+func (s *BacklogService) GetAssigneeWorkload(ctx context.Context, filter WorkloadFilter, capacities map[string]int) (*WorkloadReport, error) {
+	byAssignee := make(map[string]*AssigneeWorkload)
+	report := &WorkloadReport{}
+
+	// Open statuses come from the workflow's canonical categories so custom
+	// workspace statuses are counted too
+	workflow := model.ActiveWorkflow()
+	openStatuses := append(
+		workflow.StatusesInCategory(model.StatusCategoryTodo),
+		workflow.StatusesInCategory(model.StatusCategoryInProgress)...,
+	)
+
+	offset := 0
+	for {
+		items, total, err := s.repo.List(ctx, repository.BacklogFilter{
+			Statuses: openStatuses,
+			Tags:     filter.Tags,
+			Limit:    200,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if item.Assignee == "" {
+				report.Unassigned++
+				continue
+			}
+
+			workload, ok := byAssignee[item.Assignee]
+			if !ok {
+				workload = &AssigneeWorkload{
+					Assignee: item.Assignee,
+					Capacity: capacities[item.Assignee],
+				}
+				byAssignee[item.Assignee] = workload
+			}
+
+			workload.OpenItems++
+			if item.Status == model.ItemStatusInProgress {
+				workload.InProgress++
+				workload.PointsInFlight += item.StoryPoints
+			}
+		}
+
+		offset += len(items)
+		if int64(offset) >= total || len(items) == 0 {
+			break
+		}
+	}
+
+	for _, workload := range byAssignee {
+		if workload.Capacity > 0 && workload.PointsInFlight > workload.Capacity {
+			workload.Overallocated = true
+		}
+		report.Assignees = append(report.Assignees, workload)
+	}
+
+	// Heaviest load first so the report surfaces who needs relief
+	sort.Slice(report.Assignees, func(i, j int) bool {
+		return report.Assignees[i].PointsInFlight > report.Assignees[j].PointsInFlight
+	})
+
+	return report, nil
+}
@@ -0,0 +1,182 @@
+// services/backlog-service/internal/domain/service/worklog.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ubmm/backlog-service/internal/domain/event"
+	"github.com/ubmm/backlog-service/internal/domain/repository"
+)
+
+// WorkLogEntry records time spent by a user on an item
+type WorkLogEntry struct {
+	ID        uuid.UUID     `json:"id"`
+	ItemID    uuid.UUID     `json:"itemId"`
+	UserID    string        `json:"userId"`
+	Duration  time.Duration `json:"duration"`
+	Date      time.Time     `json:"date"`
+	Note      string        `json:"note"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// WorkLogRepository defines the interface for work log persistence
+type WorkLogRepository interface {
+	// Create stores a new work log entry
+	Create(ctx context.Context, entry *WorkLogEntry) error
+
+	// GetByID retrieves a work log entry by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*WorkLogEntry, error)
+
+	// Update updates an existing entry
+	Update(ctx context.Context, entry *WorkLogEntry) error
+
+	// Delete removes an entry
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetByItemID retrieves all entries for an item
+	GetByItemID(ctx context.Context, itemID uuid.UUID) ([]*WorkLogEntry, error)
+
+	// GetByUser retrieves a user's entries in a date range (the timesheet query)
+	GetByUser(ctx context.Context, userID string, from, to time.Time) ([]*WorkLogEntry, error)
+
+	// TotalByItemID retrieves the total logged duration for an item
+	TotalByItemID(ctx context.Context, itemID uuid.UUID) (time.Duration, error)
+}
+
+// ItemTimeSummary compares logged time against the estimate for an item
+type ItemTimeSummary struct {
+	ItemID         uuid.UUID     `json:"itemId"`
+	TotalLogged    time.Duration `json:"totalLogged"`
+	StoryPoints    int           `json:"storyPoints"`
+	EntryCount     int           `json:"entryCount"`
+}
+
+// WorkLogService manages work log entries and timesheet queries
+type WorkLogService struct {
+	worklogs       WorkLogRepository
+	repo           repository.BacklogRepository
+	eventPublisher event.Publisher
+	logger         *zap.Logger
+}
+
+// NewWorkLogService creates a new work log service
+func NewWorkLogService(worklogs WorkLogRepository, repo repository.BacklogRepository, eventPublisher event.Publisher, logger *zap.Logger) *WorkLogService {
+	return &WorkLogService{
+		worklogs:       worklogs,
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// LogWork records time spent on an item
+func (s *WorkLogService) LogWork(ctx context.Context, itemID uuid.UUID, userID string, duration time.Duration, date time.Time, note string) (*WorkLogEntry, error) {
+	if userID == "" {
+		return nil, errors.New("user ID cannot be empty")
+	}
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	// Make sure the item exists
+	if _, err := s.repo.GetByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	entry := &WorkLogEntry{
+		ID:        uuid.New(),
+		ItemID:    itemID,
+		UserID:    userID,
+		Duration:  duration,
+		Date:      date,
+		Note:      note,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.worklogs.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	// Publish for downstream billing systems
+	workEvent := event.NewWorkLoggedEvent(itemID, userID, duration)
+	if err := s.eventPublisher.Publish(ctx, "backlog.item.work.logged", workEvent); err != nil {
+		s.logger.Error("Failed to publish work logged event", zap.Error(err))
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry updates a work log entry. Only its author may change it.
+func (s *WorkLogService) UpdateEntry(ctx context.Context, id uuid.UUID, userID string, duration time.Duration, note string) (*WorkLogEntry, error) {
+	entry, err := s.worklogs.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.UserID != userID {
+		return nil, errors.New("work log entries can only be edited by their author")
+	}
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	entry.Duration = duration
+	entry.Note = note
+	entry.UpdatedAt = time.Now().UTC()
+	if err := s.worklogs.Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry removes a work log entry. Only its author may delete it.
+func (s *WorkLogService) DeleteEntry(ctx context.Context, id uuid.UUID, userID string) error {
+	entry, err := s.worklogs.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry.UserID != userID {
+		return errors.New("work log entries can only be deleted by their author")
+	}
+	return s.worklogs.Delete(ctx, id)
+}
+
+// GetItemSummary returns the logged total against the estimate for an item
+func (s *WorkLogService) GetItemSummary(ctx context.Context, itemID uuid.UUID) (*ItemTimeSummary, error) {
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.worklogs.GetByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.worklogs.TotalByItemID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ItemTimeSummary{
+		ItemID:      itemID,
+		TotalLogged: total,
+		StoryPoints: item.StoryPoints,
+		EntryCount:  len(entries),
+	}, nil
+}
+
+// GetTimesheet retrieves a user's work log entries in a date range
+func (s *WorkLogService) GetTimesheet(ctx context.Context, userID string, from, to time.Time) ([]*WorkLogEntry, error) {
+	if to.Before(from) {
+		return nil, errors.New("invalid date range")
+	}
+	return s.worklogs.GetByUser(ctx, userID, from, to)
+}